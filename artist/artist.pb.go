@@ -26,16 +26,17 @@ type Artist struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id         int64       `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	SiteId     uint32      `protobuf:"varint,2,opt,name=siteId,proto3" json:"siteId,omitempty"`
-	ArtistId   string      `protobuf:"bytes,3,opt,name=artistId,proto3" json:"artistId,omitempty"`
-	Title      string      `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
-	Thumbnail  []byte      `protobuf:"bytes,5,opt,name=thumbnail,proto3" json:"thumbnail,omitempty"`
-	UserAdded  bool        `protobuf:"varint,6,opt,name=userAdded,proto3" json:"userAdded,omitempty"`
-	NewAlbs    int32       `protobuf:"varint,7,opt,name=newAlbs,proto3" json:"newAlbs,omitempty"`
-	Albums     []*Album    `protobuf:"bytes,8,rep,name=albums,proto3" json:"albums,omitempty"`
-	Playlists  []*Playlist `protobuf:"bytes,9,rep,name=playlists,proto3" json:"playlists,omitempty"`
-	DeletedAlb []string    `protobuf:"bytes,10,rep,name=deletedAlb,proto3" json:"deletedAlb,omitempty"`
+	Id         int64         `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	SiteId     uint32        `protobuf:"varint,2,opt,name=siteId,proto3" json:"siteId,omitempty"`
+	ArtistId   string        `protobuf:"bytes,3,opt,name=artistId,proto3" json:"artistId,omitempty"`
+	Title      string        `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	Thumbnail  []byte        `protobuf:"bytes,5,opt,name=thumbnail,proto3" json:"thumbnail,omitempty"`
+	UserAdded  bool          `protobuf:"varint,6,opt,name=userAdded,proto3" json:"userAdded,omitempty"`
+	NewAlbs    int32         `protobuf:"varint,7,opt,name=newAlbs,proto3" json:"newAlbs,omitempty"`
+	Albums     []*Album      `protobuf:"bytes,8,rep,name=albums,proto3" json:"albums,omitempty"`
+	Playlists  []*Playlist   `protobuf:"bytes,9,rep,name=playlists,proto3" json:"playlists,omitempty"`
+	DeletedAlb []string      `protobuf:"bytes,10,rep,name=deletedAlb,proto3" json:"deletedAlb,omitempty"`
+	Sync       *SyncSettings `protobuf:"bytes,11,opt,name=sync,proto3" json:"sync,omitempty"`
 }
 
 func (x *Artist) Reset() {
@@ -140,6 +141,84 @@ func (x *Artist) GetDeletedAlb() []string {
 	return nil
 }
 
+func (x *Artist) GetSync() *SyncSettings {
+	if x != nil {
+		return x.Sync
+	}
+	return nil
+}
+
+type SyncSettings struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Paused        bool   `protobuf:"varint,1,opt,name=paused,proto3" json:"paused,omitempty"`               // skipped by the sync of all artists, an explicit sync still runs
+	IntervalHours int32  `protobuf:"varint,2,opt,name=intervalHours,proto3" json:"intervalHours,omitempty"` // 0 - default of the server
+	Priority      int32  `protobuf:"varint,3,opt,name=priority,proto3" json:"priority,omitempty"`           // higher are synced first, below zero wait while the youtube quota is near the budget
+	LastSync      string `protobuf:"bytes,4,opt,name=lastSync,proto3" json:"lastSync,omitempty"`            // read only
+}
+
+func (x *SyncSettings) Reset() {
+	*x = SyncSettings{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_artist_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SyncSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncSettings) ProtoMessage() {}
+
+func (x *SyncSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_artist_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncSettings.ProtoReflect.Descriptor instead.
+func (*SyncSettings) Descriptor() ([]byte, []int) {
+	return file_artist_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SyncSettings) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+func (x *SyncSettings) GetIntervalHours() int32 {
+	if x != nil {
+		return x.IntervalHours
+	}
+	return 0
+}
+
+func (x *SyncSettings) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *SyncSettings) GetLastSync() string {
+	if x != nil {
+		return x.LastSync
+	}
+	return ""
+}
+
 type Album struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -159,12 +238,14 @@ type Album struct {
 	WatchState  int32    `protobuf:"varint,12,opt,name=watchState,proto3" json:"watchState,omitempty"`
 	ArtistIds   []string `protobuf:"bytes,13,rep,name=artistIds,proto3" json:"artistIds,omitempty"`
 	Quality     float32  `protobuf:"fixed32,14,opt,name=quality,proto3" json:"quality,omitempty"`
+	RemovedAt   string   `protobuf:"bytes,15,opt,name=removedAt,proto3" json:"removedAt,omitempty"` // set when the release is gone from the artist's discography on the site
+	Kind        int32    `protobuf:"varint,16,opt,name=kind,proto3" json:"kind,omitempty"`          // video: 0 - regular, 1 - short, 2 - live, 3 - premiere
 }
 
 func (x *Album) Reset() {
 	*x = Album{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_artist_proto_msgTypes[1]
+		mi := &file_artist_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -177,7 +258,7 @@ func (x *Album) String() string {
 func (*Album) ProtoMessage() {}
 
 func (x *Album) ProtoReflect() protoreflect.Message {
-	mi := &file_artist_proto_msgTypes[1]
+	mi := &file_artist_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -190,7 +271,7 @@ func (x *Album) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Album.ProtoReflect.Descriptor instead.
 func (*Album) Descriptor() ([]byte, []int) {
-	return file_artist_proto_rawDescGZIP(), []int{1}
+	return file_artist_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *Album) GetId() int64 {
@@ -291,6 +372,131 @@ func (x *Album) GetQuality() float32 {
 	return 0
 }
 
+func (x *Album) GetRemovedAt() string {
+	if x != nil {
+		return x.RemovedAt
+	}
+	return ""
+}
+
+func (x *Album) GetKind() int32 {
+	if x != nil {
+		return x.Kind
+	}
+	return 0
+}
+
+type Track struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TrackId        string   `protobuf:"bytes,1,opt,name=trackId,proto3" json:"trackId,omitempty"`
+	Position       int32    `protobuf:"varint,2,opt,name=position,proto3" json:"position,omitempty"`
+	Title          string   `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Duration       int32    `protobuf:"varint,4,opt,name=duration,proto3" json:"duration,omitempty"` // seconds
+	Genres         []string `protobuf:"bytes,5,rep,name=genres,proto3" json:"genres,omitempty"`
+	HasFlac        bool     `protobuf:"varint,6,opt,name=hasFlac,proto3" json:"hasFlac,omitempty"`
+	HighestQuality string   `protobuf:"bytes,7,opt,name=highestQuality,proto3" json:"highestQuality,omitempty"`
+	Explicit       bool     `protobuf:"varint,8,opt,name=explicit,proto3" json:"explicit,omitempty"`
+	ArtistNames    []string `protobuf:"bytes,9,rep,name=artistNames,proto3" json:"artistNames,omitempty"`
+}
+
+func (x *Track) Reset() {
+	*x = Track{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_artist_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Track) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Track) ProtoMessage() {}
+
+func (x *Track) ProtoReflect() protoreflect.Message {
+	mi := &file_artist_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Track.ProtoReflect.Descriptor instead.
+func (*Track) Descriptor() ([]byte, []int) {
+	return file_artist_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Track) GetTrackId() string {
+	if x != nil {
+		return x.TrackId
+	}
+	return ""
+}
+
+func (x *Track) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *Track) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Track) GetDuration() int32 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *Track) GetGenres() []string {
+	if x != nil {
+		return x.Genres
+	}
+	return nil
+}
+
+func (x *Track) GetHasFlac() bool {
+	if x != nil {
+		return x.HasFlac
+	}
+	return false
+}
+
+func (x *Track) GetHighestQuality() string {
+	if x != nil {
+		return x.HighestQuality
+	}
+	return ""
+}
+
+func (x *Track) GetExplicit() bool {
+	if x != nil {
+		return x.Explicit
+	}
+	return false
+}
+
+func (x *Track) GetArtistNames() []string {
+	if x != nil {
+		return x.ArtistNames
+	}
+	return nil
+}
+
 type Playlist struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -307,7 +513,7 @@ type Playlist struct {
 func (x *Playlist) Reset() {
 	*x = Playlist{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_artist_proto_msgTypes[2]
+		mi := &file_artist_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -320,7 +526,7 @@ func (x *Playlist) String() string {
 func (*Playlist) ProtoMessage() {}
 
 func (x *Playlist) ProtoReflect() protoreflect.Message {
-	mi := &file_artist_proto_msgTypes[2]
+	mi := &file_artist_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -333,7 +539,7 @@ func (x *Playlist) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Playlist.ProtoReflect.Descriptor instead.
 func (*Playlist) Descriptor() ([]byte, []int) {
-	return file_artist_proto_rawDescGZIP(), []int{2}
+	return file_artist_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *Playlist) GetId() int64 {
@@ -391,7 +597,7 @@ type SyncArtistRequest struct {
 func (x *SyncArtistRequest) Reset() {
 	*x = SyncArtistRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_artist_proto_msgTypes[3]
+		mi := &file_artist_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -404,7 +610,7 @@ func (x *SyncArtistRequest) String() string {
 func (*SyncArtistRequest) ProtoMessage() {}
 
 func (x *SyncArtistRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_artist_proto_msgTypes[3]
+	mi := &file_artist_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -417,7 +623,7 @@ func (x *SyncArtistRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SyncArtistRequest.ProtoReflect.Descriptor instead.
 func (*SyncArtistRequest) Descriptor() ([]byte, []int) {
-	return file_artist_proto_rawDescGZIP(), []int{3}
+	return file_artist_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *SyncArtistRequest) GetSiteId() uint32 {
@@ -452,7 +658,7 @@ type SyncArtistResponse struct {
 func (x *SyncArtistResponse) Reset() {
 	*x = SyncArtistResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_artist_proto_msgTypes[4]
+		mi := &file_artist_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -465,7 +671,7 @@ func (x *SyncArtistResponse) String() string {
 func (*SyncArtistResponse) ProtoMessage() {}
 
 func (x *SyncArtistResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_artist_proto_msgTypes[4]
+	mi := &file_artist_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -478,7 +684,7 @@ func (x *SyncArtistResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SyncArtistResponse.ProtoReflect.Descriptor instead.
 func (*SyncArtistResponse) Descriptor() ([]byte, []int) {
-	return file_artist_proto_rawDescGZIP(), []int{4}
+	return file_artist_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *SyncArtistResponse) GetArtists() []*Artist {
@@ -488,33 +694,41 @@ func (x *SyncArtistResponse) GetArtists() []*Artist {
 	return nil
 }
 
-type ReadArtistAlbumRequest struct {
+type SyncArtistEvent struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SiteId   uint32 `protobuf:"varint,1,opt,name=siteId,proto3" json:"siteId,omitempty"`
-	ArtistId string `protobuf:"bytes,2,opt,name=artistId,proto3" json:"artistId,omitempty"`
-	NewOnly  bool   `protobuf:"varint,3,opt,name=newOnly,proto3" json:"newOnly,omitempty"`
-}
-
-func (x *ReadArtistAlbumRequest) Reset() {
-	*x = ReadArtistAlbumRequest{}
+	// 0 - started, 1 - new albums, 2 - new artists, 3 - error, 4 - finished, 5 - summary
+	EventType int32              `protobuf:"varint,1,opt,name=eventType,proto3" json:"eventType,omitempty"`
+	SiteId    uint32             `protobuf:"varint,2,opt,name=siteId,proto3" json:"siteId,omitempty"`
+	ArtistId  string             `protobuf:"bytes,3,opt,name=artistId,proto3" json:"artistId,omitempty"`
+	Title     string             `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	Current   int32              `protobuf:"varint,5,opt,name=current,proto3" json:"current,omitempty"`
+	Total     int32              `protobuf:"varint,6,opt,name=total,proto3" json:"total,omitempty"`
+	Albums    []*Album           `protobuf:"bytes,7,rep,name=albums,proto3" json:"albums,omitempty"`
+	Artists   []*Artist          `protobuf:"bytes,8,rep,name=artists,proto3" json:"artists,omitempty"`
+	Error     string             `protobuf:"bytes,9,opt,name=error,proto3" json:"error,omitempty"`
+	Summary   *SyncArtistSummary `protobuf:"bytes,10,opt,name=summary,proto3" json:"summary,omitempty"`
+}
+
+func (x *SyncArtistEvent) Reset() {
+	*x = SyncArtistEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_artist_proto_msgTypes[5]
+		mi := &file_artist_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ReadArtistAlbumRequest) String() string {
+func (x *SyncArtistEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReadArtistAlbumRequest) ProtoMessage() {}
+func (*SyncArtistEvent) ProtoMessage() {}
 
-func (x *ReadArtistAlbumRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_artist_proto_msgTypes[5]
+func (x *SyncArtistEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_artist_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -525,113 +739,114 @@ func (x *ReadArtistAlbumRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReadArtistAlbumRequest.ProtoReflect.Descriptor instead.
-func (*ReadArtistAlbumRequest) Descriptor() ([]byte, []int) {
-	return file_artist_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use SyncArtistEvent.ProtoReflect.Descriptor instead.
+func (*SyncArtistEvent) Descriptor() ([]byte, []int) {
+	return file_artist_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *ReadArtistAlbumRequest) GetSiteId() uint32 {
+func (x *SyncArtistEvent) GetEventType() int32 {
+	if x != nil {
+		return x.EventType
+	}
+	return 0
+}
+
+func (x *SyncArtistEvent) GetSiteId() uint32 {
 	if x != nil {
 		return x.SiteId
 	}
 	return 0
 }
 
-func (x *ReadArtistAlbumRequest) GetArtistId() string {
+func (x *SyncArtistEvent) GetArtistId() string {
 	if x != nil {
 		return x.ArtistId
 	}
 	return ""
 }
 
-func (x *ReadArtistAlbumRequest) GetNewOnly() bool {
+func (x *SyncArtistEvent) GetTitle() string {
 	if x != nil {
-		return x.NewOnly
+		return x.Title
 	}
-	return false
-}
-
-type ReadArtistAlbumResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Releases  []*Album    `protobuf:"bytes,1,rep,name=releases,proto3" json:"releases,omitempty"`
-	Playlists []*Playlist `protobuf:"bytes,2,rep,name=playlists,proto3" json:"playlists,omitempty"`
+	return ""
 }
 
-func (x *ReadArtistAlbumResponse) Reset() {
-	*x = ReadArtistAlbumResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_artist_proto_msgTypes[6]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *SyncArtistEvent) GetCurrent() int32 {
+	if x != nil {
+		return x.Current
 	}
+	return 0
 }
 
-func (x *ReadArtistAlbumResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *SyncArtistEvent) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
 }
 
-func (*ReadArtistAlbumResponse) ProtoMessage() {}
-
-func (x *ReadArtistAlbumResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_artist_proto_msgTypes[6]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *SyncArtistEvent) GetAlbums() []*Album {
+	if x != nil {
+		return x.Albums
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use ReadArtistAlbumResponse.ProtoReflect.Descriptor instead.
-func (*ReadArtistAlbumResponse) Descriptor() ([]byte, []int) {
-	return file_artist_proto_rawDescGZIP(), []int{6}
+func (x *SyncArtistEvent) GetArtists() []*Artist {
+	if x != nil {
+		return x.Artists
+	}
+	return nil
 }
 
-func (x *ReadArtistAlbumResponse) GetReleases() []*Album {
+func (x *SyncArtistEvent) GetError() string {
 	if x != nil {
-		return x.Releases
+		return x.Error
 	}
-	return nil
+	return ""
 }
 
-func (x *ReadArtistAlbumResponse) GetPlaylists() []*Playlist {
+func (x *SyncArtistEvent) GetSummary() *SyncArtistSummary {
 	if x != nil {
-		return x.Playlists
+		return x.Summary
 	}
 	return nil
 }
 
-type DeleteArtistRequest struct {
+type SyncArtistSummary struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SiteId   uint32 `protobuf:"varint,1,opt,name=siteId,proto3" json:"siteId,omitempty"`
-	ArtistId string `protobuf:"bytes,2,opt,name=artistId,proto3" json:"artistId,omitempty"`
+	ArtistCount       int32    `protobuf:"varint,1,opt,name=artistCount,proto3" json:"artistCount,omitempty"`
+	FailedCount       int32    `protobuf:"varint,2,opt,name=failedCount,proto3" json:"failedCount,omitempty"`
+	NewAlbumCount     int32    `protobuf:"varint,3,opt,name=newAlbumCount,proto3" json:"newAlbumCount,omitempty"`
+	NewArtistCount    int32    `protobuf:"varint,4,opt,name=newArtistCount,proto3" json:"newArtistCount,omitempty"`
+	DeletedArtistIds  []string `protobuf:"bytes,5,rep,name=deletedArtistIds,proto3" json:"deletedArtistIds,omitempty"`
+	RemovedAlbumCount int32    `protobuf:"varint,6,opt,name=removedAlbumCount,proto3" json:"removedAlbumCount,omitempty"`
+	RunId             int64    `protobuf:"varint,7,opt,name=runId,proto3" json:"runId,omitempty"`                          // entry of the sync journal
+	DownloadJobIds    []int64  `protobuf:"varint,8,rep,packed,name=downloadJobIds,proto3" json:"downloadJobIds,omitempty"` // downloads queued by the rules
+	SkippedCount      int32    `protobuf:"varint,9,opt,name=skippedCount,proto3" json:"skippedCount,omitempty"`            // artists left out because the sync was cancelled
 }
 
-func (x *DeleteArtistRequest) Reset() {
-	*x = DeleteArtistRequest{}
+func (x *SyncArtistSummary) Reset() {
+	*x = SyncArtistSummary{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_artist_proto_msgTypes[7]
+		mi := &file_artist_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DeleteArtistRequest) String() string {
+func (x *SyncArtistSummary) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteArtistRequest) ProtoMessage() {}
+func (*SyncArtistSummary) ProtoMessage() {}
 
-func (x *DeleteArtistRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_artist_proto_msgTypes[7]
+func (x *SyncArtistSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_artist_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -642,84 +857,95 @@ func (x *DeleteArtistRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteArtistRequest.ProtoReflect.Descriptor instead.
-func (*DeleteArtistRequest) Descriptor() ([]byte, []int) {
-	return file_artist_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use SyncArtistSummary.ProtoReflect.Descriptor instead.
+func (*SyncArtistSummary) Descriptor() ([]byte, []int) {
+	return file_artist_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *DeleteArtistRequest) GetSiteId() uint32 {
+func (x *SyncArtistSummary) GetArtistCount() int32 {
 	if x != nil {
-		return x.SiteId
+		return x.ArtistCount
 	}
 	return 0
 }
 
-func (x *DeleteArtistRequest) GetArtistId() string {
+func (x *SyncArtistSummary) GetFailedCount() int32 {
 	if x != nil {
-		return x.ArtistId
+		return x.FailedCount
 	}
-	return ""
+	return 0
 }
 
-type DeleteArtistResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	RowsAffected int64 `protobuf:"varint,1,opt,name=rowsAffected,proto3" json:"rowsAffected,omitempty"`
+func (x *SyncArtistSummary) GetNewAlbumCount() int32 {
+	if x != nil {
+		return x.NewAlbumCount
+	}
+	return 0
 }
 
-func (x *DeleteArtistResponse) Reset() {
-	*x = DeleteArtistResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_artist_proto_msgTypes[8]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *SyncArtistSummary) GetNewArtistCount() int32 {
+	if x != nil {
+		return x.NewArtistCount
 	}
+	return 0
 }
 
-func (x *DeleteArtistResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *SyncArtistSummary) GetDeletedArtistIds() []string {
+	if x != nil {
+		return x.DeletedArtistIds
+	}
+	return nil
 }
 
-func (*DeleteArtistResponse) ProtoMessage() {}
+func (x *SyncArtistSummary) GetRemovedAlbumCount() int32 {
+	if x != nil {
+		return x.RemovedAlbumCount
+	}
+	return 0
+}
 
-func (x *DeleteArtistResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_artist_proto_msgTypes[8]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *SyncArtistSummary) GetRunId() int64 {
+	if x != nil {
+		return x.RunId
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use DeleteArtistResponse.ProtoReflect.Descriptor instead.
-func (*DeleteArtistResponse) Descriptor() ([]byte, []int) {
-	return file_artist_proto_rawDescGZIP(), []int{8}
+func (x *SyncArtistSummary) GetDownloadJobIds() []int64 {
+	if x != nil {
+		return x.DownloadJobIds
+	}
+	return nil
 }
 
-func (x *DeleteArtistResponse) GetRowsAffected() int64 {
+func (x *SyncArtistSummary) GetSkippedCount() int32 {
 	if x != nil {
-		return x.RowsAffected
+		return x.SkippedCount
 	}
 	return 0
 }
 
-type SetPlannedRequest struct {
+type ReadArtistAlbumRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SiteId  uint32 `protobuf:"varint,1,opt,name=siteId,proto3" json:"siteId,omitempty"`
-	VideoId string `protobuf:"bytes,2,opt,name=videoId,proto3" json:"videoId,omitempty"`
-	State   uint32 `protobuf:"varint,3,opt,name=state,proto3" json:"state,omitempty"`
+	SiteId       uint32  `protobuf:"varint,1,opt,name=siteId,proto3" json:"siteId,omitempty"`
+	ArtistId     string  `protobuf:"bytes,2,opt,name=artistId,proto3" json:"artistId,omitempty"`
+	NewOnly      bool    `protobuf:"varint,3,opt,name=newOnly,proto3" json:"newOnly,omitempty"`
+	PageSize     int32   `protobuf:"varint,4,opt,name=pageSize,proto3" json:"pageSize,omitempty"` // 0 - all rows
+	PageToken    string  `protobuf:"bytes,5,opt,name=pageToken,proto3" json:"pageToken,omitempty"`
+	ReleaseTypes []int32 `protobuf:"varint,6,rep,packed,name=releaseTypes,proto3" json:"releaseTypes,omitempty"` // 0 album, 1 single, 3 video, 4 compilation, empty - all
+	DateFrom     string  `protobuf:"bytes,7,opt,name=dateFrom,proto3" json:"dateFrom,omitempty"`                 // yyyy-mm-dd, inclusive
+	DateTo       string  `protobuf:"bytes,8,opt,name=dateTo,proto3" json:"dateTo,omitempty"`                     // yyyy-mm-dd, inclusive
+	SortKey      int32   `protobuf:"varint,9,opt,name=sortKey,proto3" json:"sortKey,omitempty"`                  // 0 default (new first), 1 date, 2 views, 3 likes, 4 quality
+	SortAsc      bool    `protobuf:"varint,10,opt,name=sortAsc,proto3" json:"sortAsc,omitempty"`
+	Removed      int32   `protobuf:"varint,11,opt,name=removed,proto3" json:"removed,omitempty"`    // 0 all, 1 hide removed, 2 removed only
+	Kinds        []int32 `protobuf:"varint,12,rep,packed,name=kinds,proto3" json:"kinds,omitempty"` // video kinds, empty - all
 }
 
-func (x *SetPlannedRequest) Reset() {
-	*x = SetPlannedRequest{}
+func (x *ReadArtistAlbumRequest) Reset() {
+	*x = ReadArtistAlbumRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_artist_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -727,13 +953,13 @@ func (x *SetPlannedRequest) Reset() {
 	}
 }
 
-func (x *SetPlannedRequest) String() string {
+func (x *ReadArtistAlbumRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetPlannedRequest) ProtoMessage() {}
+func (*ReadArtistAlbumRequest) ProtoMessage() {}
 
-func (x *SetPlannedRequest) ProtoReflect() protoreflect.Message {
+func (x *ReadArtistAlbumRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_artist_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -745,42 +971,107 @@ func (x *SetPlannedRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetPlannedRequest.ProtoReflect.Descriptor instead.
-func (*SetPlannedRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReadArtistAlbumRequest.ProtoReflect.Descriptor instead.
+func (*ReadArtistAlbumRequest) Descriptor() ([]byte, []int) {
 	return file_artist_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *SetPlannedRequest) GetSiteId() uint32 {
+func (x *ReadArtistAlbumRequest) GetSiteId() uint32 {
 	if x != nil {
 		return x.SiteId
 	}
 	return 0
 }
 
-func (x *SetPlannedRequest) GetVideoId() string {
+func (x *ReadArtistAlbumRequest) GetArtistId() string {
 	if x != nil {
-		return x.VideoId
+		return x.ArtistId
 	}
 	return ""
 }
 
-func (x *SetPlannedRequest) GetState() uint32 {
+func (x *ReadArtistAlbumRequest) GetNewOnly() bool {
 	if x != nil {
-		return x.State
+		return x.NewOnly
+	}
+	return false
+}
+
+func (x *ReadArtistAlbumRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
 	}
 	return 0
 }
 
-type SetPlannedResponse struct {
+func (x *ReadArtistAlbumRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ReadArtistAlbumRequest) GetReleaseTypes() []int32 {
+	if x != nil {
+		return x.ReleaseTypes
+	}
+	return nil
+}
+
+func (x *ReadArtistAlbumRequest) GetDateFrom() string {
+	if x != nil {
+		return x.DateFrom
+	}
+	return ""
+}
+
+func (x *ReadArtistAlbumRequest) GetDateTo() string {
+	if x != nil {
+		return x.DateTo
+	}
+	return ""
+}
+
+func (x *ReadArtistAlbumRequest) GetSortKey() int32 {
+	if x != nil {
+		return x.SortKey
+	}
+	return 0
+}
+
+func (x *ReadArtistAlbumRequest) GetSortAsc() bool {
+	if x != nil {
+		return x.SortAsc
+	}
+	return false
+}
+
+func (x *ReadArtistAlbumRequest) GetRemoved() int32 {
+	if x != nil {
+		return x.Removed
+	}
+	return 0
+}
+
+func (x *ReadArtistAlbumRequest) GetKinds() []int32 {
+	if x != nil {
+		return x.Kinds
+	}
+	return nil
+}
+
+type ReadArtistAlbumResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RowsAffected int64 `protobuf:"varint,1,opt,name=rowsAffected,proto3" json:"rowsAffected,omitempty"`
+	Releases      []*Album    `protobuf:"bytes,1,rep,name=releases,proto3" json:"releases,omitempty"`
+	Playlists     []*Playlist `protobuf:"bytes,2,rep,name=playlists,proto3" json:"playlists,omitempty"`
+	NextPageToken string      `protobuf:"bytes,3,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"`
 }
 
-func (x *SetPlannedResponse) Reset() {
-	*x = SetPlannedResponse{}
+func (x *ReadArtistAlbumResponse) Reset() {
+	*x = ReadArtistAlbumResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_artist_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -788,13 +1079,13 @@ func (x *SetPlannedResponse) Reset() {
 	}
 }
 
-func (x *SetPlannedResponse) String() string {
+func (x *ReadArtistAlbumResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetPlannedResponse) ProtoMessage() {}
+func (*ReadArtistAlbumResponse) ProtoMessage() {}
 
-func (x *SetPlannedResponse) ProtoReflect() protoreflect.Message {
+func (x *ReadArtistAlbumResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_artist_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -806,28 +1097,43 @@ func (x *SetPlannedResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetPlannedResponse.ProtoReflect.Descriptor instead.
-func (*SetPlannedResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReadArtistAlbumResponse.ProtoReflect.Descriptor instead.
+func (*ReadArtistAlbumResponse) Descriptor() ([]byte, []int) {
 	return file_artist_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *SetPlannedResponse) GetRowsAffected() int64 {
+func (x *ReadArtistAlbumResponse) GetReleases() []*Album {
 	if x != nil {
-		return x.RowsAffected
+		return x.Releases
 	}
-	return 0
+	return nil
 }
 
-type ClearSyncRequest struct {
+func (x *ReadArtistAlbumResponse) GetPlaylists() []*Playlist {
+	if x != nil {
+		return x.Playlists
+	}
+	return nil
+}
+
+func (x *ReadArtistAlbumResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type ReadAlbumTracksRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SiteId uint32 `protobuf:"varint,1,opt,name=siteId,proto3" json:"siteId,omitempty"`
+	SiteId  uint32 `protobuf:"varint,1,opt,name=siteId,proto3" json:"siteId,omitempty"`
+	AlbumId string `protobuf:"bytes,2,opt,name=albumId,proto3" json:"albumId,omitempty"`
 }
 
-func (x *ClearSyncRequest) Reset() {
-	*x = ClearSyncRequest{}
+func (x *ReadAlbumTracksRequest) Reset() {
+	*x = ReadAlbumTracksRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_artist_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -835,13 +1141,13 @@ func (x *ClearSyncRequest) Reset() {
 	}
 }
 
-func (x *ClearSyncRequest) String() string {
+func (x *ReadAlbumTracksRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ClearSyncRequest) ProtoMessage() {}
+func (*ReadAlbumTracksRequest) ProtoMessage() {}
 
-func (x *ClearSyncRequest) ProtoReflect() protoreflect.Message {
+func (x *ReadAlbumTracksRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_artist_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -853,28 +1159,35 @@ func (x *ClearSyncRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ClearSyncRequest.ProtoReflect.Descriptor instead.
-func (*ClearSyncRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReadAlbumTracksRequest.ProtoReflect.Descriptor instead.
+func (*ReadAlbumTracksRequest) Descriptor() ([]byte, []int) {
 	return file_artist_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *ClearSyncRequest) GetSiteId() uint32 {
+func (x *ReadAlbumTracksRequest) GetSiteId() uint32 {
 	if x != nil {
 		return x.SiteId
 	}
 	return 0
 }
 
-type ClearSyncResponse struct {
+func (x *ReadAlbumTracksRequest) GetAlbumId() string {
+	if x != nil {
+		return x.AlbumId
+	}
+	return ""
+}
+
+type ReadAlbumTracksResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RowsAffected int64 `protobuf:"varint,1,opt,name=rowsAffected,proto3" json:"rowsAffected,omitempty"`
+	Tracks []*Track `protobuf:"bytes,1,rep,name=tracks,proto3" json:"tracks,omitempty"`
 }
 
-func (x *ClearSyncResponse) Reset() {
-	*x = ClearSyncResponse{}
+func (x *ReadAlbumTracksResponse) Reset() {
+	*x = ReadAlbumTracksResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_artist_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -882,13 +1195,13 @@ func (x *ClearSyncResponse) Reset() {
 	}
 }
 
-func (x *ClearSyncResponse) String() string {
+func (x *ReadAlbumTracksResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ClearSyncResponse) ProtoMessage() {}
+func (*ReadAlbumTracksResponse) ProtoMessage() {}
 
-func (x *ClearSyncResponse) ProtoReflect() protoreflect.Message {
+func (x *ReadAlbumTracksResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_artist_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -900,31 +1213,29 @@ func (x *ClearSyncResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ClearSyncResponse.ProtoReflect.Descriptor instead.
-func (*ClearSyncResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReadAlbumTracksResponse.ProtoReflect.Descriptor instead.
+func (*ReadAlbumTracksResponse) Descriptor() ([]byte, []int) {
 	return file_artist_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *ClearSyncResponse) GetRowsAffected() int64 {
+func (x *ReadAlbumTracksResponse) GetTracks() []*Track {
 	if x != nil {
-		return x.RowsAffected
+		return x.Tracks
 	}
-	return 0
+	return nil
 }
 
-type DownloadAlbumsRequest struct {
+type DeleteArtistRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SiteId       uint32   `protobuf:"varint,1,opt,name=siteId,proto3" json:"siteId,omitempty"`
-	AlbumIds     []string `protobuf:"bytes,2,rep,name=albumIds,proto3" json:"albumIds,omitempty"`
-	TrackQuality string   `protobuf:"bytes,3,opt,name=trackQuality,proto3" json:"trackQuality,omitempty"`
-	IsPl         bool     `protobuf:"varint,4,opt,name=isPl,proto3" json:"isPl,omitempty"`
+	SiteId   uint32 `protobuf:"varint,1,opt,name=siteId,proto3" json:"siteId,omitempty"`
+	ArtistId string `protobuf:"bytes,2,opt,name=artistId,proto3" json:"artistId,omitempty"`
 }
 
-func (x *DownloadAlbumsRequest) Reset() {
-	*x = DownloadAlbumsRequest{}
+func (x *DeleteArtistRequest) Reset() {
+	*x = DeleteArtistRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_artist_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -932,13 +1243,13 @@ func (x *DownloadAlbumsRequest) Reset() {
 	}
 }
 
-func (x *DownloadAlbumsRequest) String() string {
+func (x *DeleteArtistRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DownloadAlbumsRequest) ProtoMessage() {}
+func (*DeleteArtistRequest) ProtoMessage() {}
 
-func (x *DownloadAlbumsRequest) ProtoReflect() protoreflect.Message {
+func (x *DeleteArtistRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_artist_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -950,51 +1261,35 @@ func (x *DownloadAlbumsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DownloadAlbumsRequest.ProtoReflect.Descriptor instead.
-func (*DownloadAlbumsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteArtistRequest.ProtoReflect.Descriptor instead.
+func (*DeleteArtistRequest) Descriptor() ([]byte, []int) {
 	return file_artist_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *DownloadAlbumsRequest) GetSiteId() uint32 {
+func (x *DeleteArtistRequest) GetSiteId() uint32 {
 	if x != nil {
 		return x.SiteId
 	}
 	return 0
 }
 
-func (x *DownloadAlbumsRequest) GetAlbumIds() []string {
-	if x != nil {
-		return x.AlbumIds
-	}
-	return nil
-}
-
-func (x *DownloadAlbumsRequest) GetTrackQuality() string {
+func (x *DeleteArtistRequest) GetArtistId() string {
 	if x != nil {
-		return x.TrackQuality
+		return x.ArtistId
 	}
 	return ""
 }
 
-func (x *DownloadAlbumsRequest) GetIsPl() bool {
-	if x != nil {
-		return x.IsPl
-	}
-	return false
-}
-
-type DownloadArtistRequest struct {
+type DeleteArtistResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SiteId       uint32 `protobuf:"varint,1,opt,name=siteId,proto3" json:"siteId,omitempty"`
-	ArtistId     string `protobuf:"bytes,2,opt,name=artistId,proto3" json:"artistId,omitempty"`
-	TrackQuality string `protobuf:"bytes,3,opt,name=trackQuality,proto3" json:"trackQuality,omitempty"`
+	RowsAffected int64 `protobuf:"varint,1,opt,name=rowsAffected,proto3" json:"rowsAffected,omitempty"`
 }
 
-func (x *DownloadArtistRequest) Reset() {
-	*x = DownloadArtistRequest{}
+func (x *DeleteArtistResponse) Reset() {
+	*x = DeleteArtistResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_artist_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1002,13 +1297,13 @@ func (x *DownloadArtistRequest) Reset() {
 	}
 }
 
-func (x *DownloadArtistRequest) String() string {
+func (x *DeleteArtistResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DownloadArtistRequest) ProtoMessage() {}
+func (*DeleteArtistResponse) ProtoMessage() {}
 
-func (x *DownloadArtistRequest) ProtoReflect() protoreflect.Message {
+func (x *DeleteArtistResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_artist_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1020,57 +1315,106 @@ func (x *DownloadArtistRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DownloadArtistRequest.ProtoReflect.Descriptor instead.
-func (*DownloadArtistRequest) Descriptor() ([]byte, []int) {
-	return file_artist_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use DeleteArtistResponse.ProtoReflect.Descriptor instead.
+func (*DeleteArtistResponse) Descriptor() ([]byte, []int) {
+	return file_artist_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *DownloadArtistRequest) GetSiteId() uint32 {
+func (x *DeleteArtistResponse) GetRowsAffected() int64 {
+	if x != nil {
+		return x.RowsAffected
+	}
+	return 0
+}
+
+type SetPlannedRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SiteId  uint32 `protobuf:"varint,1,opt,name=siteId,proto3" json:"siteId,omitempty"`
+	VideoId string `protobuf:"bytes,2,opt,name=videoId,proto3" json:"videoId,omitempty"`
+	State   uint32 `protobuf:"varint,3,opt,name=state,proto3" json:"state,omitempty"`
+}
+
+func (x *SetPlannedRequest) Reset() {
+	*x = SetPlannedRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_artist_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetPlannedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPlannedRequest) ProtoMessage() {}
+
+func (x *SetPlannedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_artist_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPlannedRequest.ProtoReflect.Descriptor instead.
+func (*SetPlannedRequest) Descriptor() ([]byte, []int) {
+	return file_artist_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *SetPlannedRequest) GetSiteId() uint32 {
 	if x != nil {
 		return x.SiteId
 	}
 	return 0
 }
 
-func (x *DownloadArtistRequest) GetArtistId() string {
+func (x *SetPlannedRequest) GetVideoId() string {
 	if x != nil {
-		return x.ArtistId
+		return x.VideoId
 	}
 	return ""
 }
 
-func (x *DownloadArtistRequest) GetTrackQuality() string {
+func (x *SetPlannedRequest) GetState() uint32 {
 	if x != nil {
-		return x.TrackQuality
+		return x.State
 	}
-	return ""
+	return 0
 }
 
-type DownloadAlbumsResponse struct {
+type SetPlannedResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Downloaded map[string]string `protobuf:"bytes,1,rep,name=Downloaded,proto3" json:"Downloaded,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	RowsAffected int64 `protobuf:"varint,1,opt,name=rowsAffected,proto3" json:"rowsAffected,omitempty"`
 }
 
-func (x *DownloadAlbumsResponse) Reset() {
-	*x = DownloadAlbumsResponse{}
+func (x *SetPlannedResponse) Reset() {
+	*x = SetPlannedResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_artist_proto_msgTypes[15]
+		mi := &file_artist_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DownloadAlbumsResponse) String() string {
+func (x *SetPlannedResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DownloadAlbumsResponse) ProtoMessage() {}
+func (*SetPlannedResponse) ProtoMessage() {}
 
-func (x *DownloadAlbumsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_artist_proto_msgTypes[15]
+func (x *SetPlannedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_artist_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1081,430 +1425,5422 @@ func (x *DownloadAlbumsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DownloadAlbumsResponse.ProtoReflect.Descriptor instead.
-func (*DownloadAlbumsResponse) Descriptor() ([]byte, []int) {
-	return file_artist_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use SetPlannedResponse.ProtoReflect.Descriptor instead.
+func (*SetPlannedResponse) Descriptor() ([]byte, []int) {
+	return file_artist_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *DownloadAlbumsResponse) GetDownloaded() map[string]string {
+func (x *SetPlannedResponse) GetRowsAffected() int64 {
 	if x != nil {
-		return x.Downloaded
+		return x.RowsAffected
+	}
+	return 0
+}
+
+type ClearSyncRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SiteId uint32 `protobuf:"varint,1,opt,name=siteId,proto3" json:"siteId,omitempty"`
+}
+
+func (x *ClearSyncRequest) Reset() {
+	*x = ClearSyncRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_artist_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClearSyncRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearSyncRequest) ProtoMessage() {}
+
+func (x *ClearSyncRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_artist_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearSyncRequest.ProtoReflect.Descriptor instead.
+func (*ClearSyncRequest) Descriptor() ([]byte, []int) {
+	return file_artist_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ClearSyncRequest) GetSiteId() uint32 {
+	if x != nil {
+		return x.SiteId
+	}
+	return 0
+}
+
+type ClearSyncResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RowsAffected int64 `protobuf:"varint,1,opt,name=rowsAffected,proto3" json:"rowsAffected,omitempty"`
+}
+
+func (x *ClearSyncResponse) Reset() {
+	*x = ClearSyncResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_artist_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClearSyncResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearSyncResponse) ProtoMessage() {}
+
+func (x *ClearSyncResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_artist_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearSyncResponse.ProtoReflect.Descriptor instead.
+func (*ClearSyncResponse) Descriptor() ([]byte, []int) {
+	return file_artist_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ClearSyncResponse) GetRowsAffected() int64 {
+	if x != nil {
+		return x.RowsAffected
+	}
+	return 0
+}
+
+type AcknowledgeItemsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SiteId uint32   `protobuf:"varint,1,opt,name=siteId,proto3" json:"siteId,omitempty"`
+	Ids    []string `protobuf:"bytes,2,rep,name=ids,proto3" json:"ids,omitempty"` // albumId or videoId
+}
+
+func (x *AcknowledgeItemsRequest) Reset() {
+	*x = AcknowledgeItemsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_artist_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AcknowledgeItemsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcknowledgeItemsRequest) ProtoMessage() {}
+
+func (x *AcknowledgeItemsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_artist_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcknowledgeItemsRequest.ProtoReflect.Descriptor instead.
+func (*AcknowledgeItemsRequest) Descriptor() ([]byte, []int) {
+	return file_artist_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *AcknowledgeItemsRequest) GetSiteId() uint32 {
+	if x != nil {
+		return x.SiteId
+	}
+	return 0
+}
+
+func (x *AcknowledgeItemsRequest) GetIds() []string {
+	if x != nil {
+		return x.Ids
 	}
 	return nil
 }
 
-type DownloadTracksResponse struct {
+type AcknowledgeArtistRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Downloaded map[string]string `protobuf:"bytes,1,rep,name=Downloaded,proto3" json:"Downloaded,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	SiteId   uint32 `protobuf:"varint,1,opt,name=siteId,proto3" json:"siteId,omitempty"`
+	ArtistId string `protobuf:"bytes,2,opt,name=artistId,proto3" json:"artistId,omitempty"`
 }
 
-func (x *DownloadTracksResponse) Reset() {
-	*x = DownloadTracksResponse{}
+func (x *AcknowledgeArtistRequest) Reset() {
+	*x = AcknowledgeArtistRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_artist_proto_msgTypes[16]
+		mi := &file_artist_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DownloadTracksResponse) String() string {
+func (x *AcknowledgeArtistRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DownloadTracksResponse) ProtoMessage() {}
+func (*AcknowledgeArtistRequest) ProtoMessage() {}
 
-func (x *DownloadTracksResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_artist_proto_msgTypes[16]
+func (x *AcknowledgeArtistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_artist_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use DownloadTracksResponse.ProtoReflect.Descriptor instead.
-func (*DownloadTracksResponse) Descriptor() ([]byte, []int) {
-	return file_artist_proto_rawDescGZIP(), []int{16}
-}
-
-func (x *DownloadTracksResponse) GetDownloaded() map[string]string {
-	if x != nil {
-		return x.Downloaded
-	}
-	return nil
-}
-
-type ListArtistRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	SiteId uint32 `protobuf:"varint,1,opt,name=siteId,proto3" json:"siteId,omitempty"`
-}
-
-func (x *ListArtistRequest) Reset() {
-	*x = ListArtistRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_artist_proto_msgTypes[17]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *ListArtistRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*ListArtistRequest) ProtoMessage() {}
-
-func (x *ListArtistRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_artist_proto_msgTypes[17]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcknowledgeArtistRequest.ProtoReflect.Descriptor instead.
+func (*AcknowledgeArtistRequest) Descriptor() ([]byte, []int) {
+	return file_artist_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *AcknowledgeArtistRequest) GetSiteId() uint32 {
+	if x != nil {
+		return x.SiteId
+	}
+	return 0
+}
+
+func (x *AcknowledgeArtistRequest) GetArtistId() string {
+	if x != nil {
+		return x.ArtistId
+	}
+	return ""
+}
+
+type AcknowledgeOlderThanRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SiteId uint32 `protobuf:"varint,1,opt,name=siteId,proto3" json:"siteId,omitempty"`
+	Date   string `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"` // yyyy-mm-dd, releases before this day
+}
+
+func (x *AcknowledgeOlderThanRequest) Reset() {
+	*x = AcknowledgeOlderThanRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_artist_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AcknowledgeOlderThanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcknowledgeOlderThanRequest) ProtoMessage() {}
+
+func (x *AcknowledgeOlderThanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_artist_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcknowledgeOlderThanRequest.ProtoReflect.Descriptor instead.
+func (*AcknowledgeOlderThanRequest) Descriptor() ([]byte, []int) {
+	return file_artist_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *AcknowledgeOlderThanRequest) GetSiteId() uint32 {
+	if x != nil {
+		return x.SiteId
+	}
+	return 0
+}
+
+func (x *AcknowledgeOlderThanRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+type AcknowledgeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RowsAffected int64 `protobuf:"varint,1,opt,name=rowsAffected,proto3" json:"rowsAffected,omitempty"`
+	RemainingNew int64 `protobuf:"varint,2,opt,name=remainingNew,proto3" json:"remainingNew,omitempty"`
+}
+
+func (x *AcknowledgeResponse) Reset() {
+	*x = AcknowledgeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_artist_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AcknowledgeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcknowledgeResponse) ProtoMessage() {}
+
+func (x *AcknowledgeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_artist_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcknowledgeResponse.ProtoReflect.Descriptor instead.
+func (*AcknowledgeResponse) Descriptor() ([]byte, []int) {
+	return file_artist_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *AcknowledgeResponse) GetRowsAffected() int64 {
+	if x != nil {
+		return x.RowsAffected
+	}
+	return 0
+}
+
+func (x *AcknowledgeResponse) GetRemainingNew() int64 {
+	if x != nil {
+		return x.RemainingNew
+	}
+	return 0
+}
+
+type DownloadAlbumsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SiteId       uint32   `protobuf:"varint,1,opt,name=siteId,proto3" json:"siteId,omitempty"`
+	AlbumIds     []string `protobuf:"bytes,2,rep,name=albumIds,proto3" json:"albumIds,omitempty"`
+	TrackQuality string   `protobuf:"bytes,3,opt,name=trackQuality,proto3" json:"trackQuality,omitempty"`
+	IsPl         bool     `protobuf:"varint,4,opt,name=isPl,proto3" json:"isPl,omitempty"`
+}
+
+func (x *DownloadAlbumsRequest) Reset() {
+	*x = DownloadAlbumsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_artist_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DownloadAlbumsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadAlbumsRequest) ProtoMessage() {}
+
+func (x *DownloadAlbumsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_artist_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadAlbumsRequest.ProtoReflect.Descriptor instead.
+func (*DownloadAlbumsRequest) Descriptor() ([]byte, []int) {
+	return file_artist_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *DownloadAlbumsRequest) GetSiteId() uint32 {
+	if x != nil {
+		return x.SiteId
+	}
+	return 0
+}
+
+func (x *DownloadAlbumsRequest) GetAlbumIds() []string {
+	if x != nil {
+		return x.AlbumIds
+	}
+	return nil
+}
+
+func (x *DownloadAlbumsRequest) GetTrackQuality() string {
+	if x != nil {
+		return x.TrackQuality
+	}
+	return ""
+}
+
+func (x *DownloadAlbumsRequest) GetIsPl() bool {
+	if x != nil {
+		return x.IsPl
+	}
+	return false
+}
+
+type DownloadArtistRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SiteId       uint32 `protobuf:"varint,1,opt,name=siteId,proto3" json:"siteId,omitempty"`
+	ArtistId     string `protobuf:"bytes,2,opt,name=artistId,proto3" json:"artistId,omitempty"`
+	TrackQuality string `protobuf:"bytes,3,opt,name=trackQuality,proto3" json:"trackQuality,omitempty"`
+}
+
+func (x *DownloadArtistRequest) Reset() {
+	*x = DownloadArtistRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_artist_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DownloadArtistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadArtistRequest) ProtoMessage() {}
+
+func (x *DownloadArtistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_artist_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadArtistRequest.ProtoReflect.Descriptor instead.
+func (*DownloadArtistRequest) Descriptor() ([]byte, []int) {
+	return file_artist_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *DownloadArtistRequest) GetSiteId() uint32 {
+	if x != nil {
+		return x.SiteId
+	}
+	return 0
+}
+
+func (x *DownloadArtistRequest) GetArtistId() string {
+	if x != nil {
+		return x.ArtistId
+	}
+	return ""
+}
+
+func (x *DownloadArtistRequest) GetTrackQuality() string {
+	if x != nil {
+		return x.TrackQuality
+	}
+	return ""
+}
+
+type DownloadAlbumsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Downloaded map[string]string `protobuf:"bytes,1,rep,name=Downloaded,proto3" json:"Downloaded,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *DownloadAlbumsResponse) Reset() {
+	*x = DownloadAlbumsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_artist_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DownloadAlbumsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadAlbumsResponse) ProtoMessage() {}
+
+func (x *DownloadAlbumsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_artist_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadAlbumsResponse.ProtoReflect.Descriptor instead.
+func (*DownloadAlbumsResponse) Descriptor() ([]byte, []int) {
+	return file_artist_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *DownloadAlbumsResponse) GetDownloaded() map[string]string {
+	if x != nil {
+		return x.Downloaded
+	}
+	return nil
+}
+
+type DownloadTracksResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Downloaded map[string]string `protobuf:"bytes,1,rep,name=Downloaded,proto3" json:"Downloaded,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *DownloadTracksResponse) Reset() {
+	*x = DownloadTracksResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_artist_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DownloadTracksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadTracksResponse) ProtoMessage() {}
+
+func (x *DownloadTracksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_artist_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadTracksResponse.ProtoReflect.Descriptor instead.
+func (*DownloadTracksResponse) Descriptor() ([]byte, []int) {
+	return file_artist_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *DownloadTracksResponse) GetDownloaded() map[string]string {
+	if x != nil {
+		return x.Downloaded
+	}
+	return nil
+}
+
+type DownloadEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 0 - queued, 1 - started, 2 - progress, 3 - tagged, 4 - skipped, 5 - failed, 6 - finished
+	EventType  int32  `protobuf:"varint,1,opt,name=eventType,proto3" json:"eventType,omitempty"`
+	SiteId     uint32 `protobuf:"varint,2,opt,name=siteId,proto3" json:"siteId,omitempty"`
+	AlbumId    string `protobuf:"bytes,3,opt,name=albumId,proto3" json:"albumId,omitempty"`
+	TrackId    string `protobuf:"bytes,4,opt,name=trackId,proto3" json:"trackId,omitempty"`
+	Title      string `protobuf:"bytes,5,opt,name=title,proto3" json:"title,omitempty"`
+	TrackNum   int32  `protobuf:"varint,6,opt,name=trackNum,proto3" json:"trackNum,omitempty"`
+	TrackTotal int32  `protobuf:"varint,7,opt,name=trackTotal,proto3" json:"trackTotal,omitempty"`
+	Bytes      int64  `protobuf:"varint,8,opt,name=bytes,proto3" json:"bytes,omitempty"`
+	TotalBytes int64  `protobuf:"varint,9,opt,name=totalBytes,proto3" json:"totalBytes,omitempty"`
+	Speed      int64  `protobuf:"varint,10,opt,name=speed,proto3" json:"speed,omitempty"`
+	Quality    string `protobuf:"bytes,11,opt,name=quality,proto3" json:"quality,omitempty"`
+	Error      string `protobuf:"bytes,12,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *DownloadEvent) Reset() {
+	*x = DownloadEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_artist_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DownloadEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadEvent) ProtoMessage() {}
+
+func (x *DownloadEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_artist_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadEvent.ProtoReflect.Descriptor instead.
+func (*DownloadEvent) Descriptor() ([]byte, []int) {
+	return file_artist_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *DownloadEvent) GetEventType() int32 {
+	if x != nil {
+		return x.EventType
+	}
+	return 0
+}
+
+func (x *DownloadEvent) GetSiteId() uint32 {
+	if x != nil {
+		return x.SiteId
+	}
+	return 0
+}
+
+func (x *DownloadEvent) GetAlbumId() string {
+	if x != nil {
+		return x.AlbumId
+	}
+	return ""
+}
+
+func (x *DownloadEvent) GetTrackId() string {
+	if x != nil {
+		return x.TrackId
+	}
+	return ""
+}
+
+func (x *DownloadEvent) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *DownloadEvent) GetTrackNum() int32 {
+	if x != nil {
+		return x.TrackNum
+	}
+	return 0
+}
+
+func (x *DownloadEvent) GetTrackTotal() int32 {
+	if x != nil {
+		return x.TrackTotal
+	}
+	return 0
+}
+
+func (x *DownloadEvent) GetBytes() int64 {
+	if x != nil {
+		return x.Bytes
+	}
+	return 0
+}
+
+func (x *DownloadEvent) GetTotalBytes() int64 {
+	if x != nil {
+		return x.TotalBytes
+	}
+	return 0
+}
+
+func (x *DownloadEvent) GetSpeed() int64 {
+	if x != nil {
+		return x.Speed
+	}
+	return 0
+}
+
+func (x *DownloadEvent) GetQuality() string {
+	if x != nil {
+		return x.Quality
+	}
+	return ""
+}
+
+func (x *DownloadEvent) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ListArtistRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SiteId uint32 `protobuf:"varint,1,opt,name=siteId,proto3" json:"siteId,omitempty"`
+}
+
+func (x *ListArtistRequest) Reset() {
+	*x = ListArtistRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_artist_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListArtistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListArtistRequest) ProtoMessage() {}
+
+func (x *ListArtistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_artist_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListArtistRequest.ProtoReflect.Descriptor instead.
+func (*ListArtistRequest) Descriptor() ([]byte, []int) {
+	return file_artist_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListArtistRequest) GetSiteId() uint32 {
+	if x != nil {
+		return x.SiteId
+	}
+	return 0
+}
+
+type ListArtistResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Artists []*Artist `protobuf:"bytes,1,rep,name=artists,proto3" json:"artists,omitempty"`
+}
+
+func (x *ListArtistResponse) Reset() {
+	*x = ListArtistResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_artist_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListArtistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListArtistResponse) ProtoMessage() {}
+
+func (x *ListArtistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_artist_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListArtistResponse.ProtoReflect.Descriptor instead.
+func (*ListArtistResponse) Descriptor() ([]byte, []int) {
+	return file_artist_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ListArtistResponse) GetArtists() []*Artist {
+	if x != nil {
+		return x.Artists
+	}
+	return nil
+}
+
+type Job struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	SiteId uint32 `protobuf:"varint,2,opt,name=siteId,proto3" json:"siteId,omitempty"`
+	// 0 - download albums, 1 - download artist, 2 - sync artist, 3 - bulk add artists
+	JobType int32 `protobuf:"varint,3,opt,name=jobType,proto3" json:"jobType,omitempty"`
+	// 0 - queued, 1 - running, 2 - done, 3 - failed, 4 - cancelled, 5 - interrupted
+	State        int32             `protobuf:"varint,4,opt,name=state,proto3" json:"state,omitempty"`
+	ArtistId     string            `protobuf:"bytes,5,opt,name=artistId,proto3" json:"artistId,omitempty"`
+	AlbumIds     []string          `protobuf:"bytes,6,rep,name=albumIds,proto3" json:"albumIds,omitempty"`
+	TrackQuality string            `protobuf:"bytes,7,opt,name=trackQuality,proto3" json:"trackQuality,omitempty"`
+	IsPl         bool              `protobuf:"varint,8,opt,name=isPl,proto3" json:"isPl,omitempty"`
+	Progress     int32             `protobuf:"varint,9,opt,name=progress,proto3" json:"progress,omitempty"`
+	Total        int32             `protobuf:"varint,10,opt,name=total,proto3" json:"total,omitempty"`
+	Result       map[string]string `protobuf:"bytes,11,rep,name=result,proto3" json:"result,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Error        string            `protobuf:"bytes,12,opt,name=error,proto3" json:"error,omitempty"`
+	Created      string            `protobuf:"bytes,13,opt,name=created,proto3" json:"created,omitempty"`
+	Updated      string            `protobuf:"bytes,14,opt,name=updated,proto3" json:"updated,omitempty"`
+	ArtistIds    []string          `protobuf:"bytes,15,rep,name=artistIds,proto3" json:"artistIds,omitempty"`
+}
+
+func (x *Job) Reset() {
+	*x = Job{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_artist_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Job) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Job) ProtoMessage() {}
+
+func (x *Job) ProtoReflect() protoreflect.Message {
+	mi := &file_artist_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Job.ProtoReflect.Descriptor instead.
+func (*Job) Descriptor() ([]byte, []int) {
+	return file_artist_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *Job) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Job) GetSiteId() uint32 {
+	if x != nil {
+		return x.SiteId
+	}
+	return 0
+}
+
+func (x *Job) GetJobType() int32 {
+	if x != nil {
+		return x.JobType
+	}
+	return 0
+}
+
+func (x *Job) GetState() int32 {
+	if x != nil {
+		return x.State
+	}
+	return 0
+}
+
+func (x *Job) GetArtistId() string {
+	if x != nil {
+		return x.ArtistId
+	}
+	return ""
+}
+
+func (x *Job) GetAlbumIds() []string {
+	if x != nil {
+		return x.AlbumIds
+	}
+	return nil
+}
+
+func (x *Job) GetTrackQuality() string {
+	if x != nil {
+		return x.TrackQuality
+	}
+	return ""
+}
+
+func (x *Job) GetIsPl() bool {
+	if x != nil {
+		return x.IsPl
+	}
+	return false
+}
+
+func (x *Job) GetProgress() int32 {
+	if x != nil {
+		return x.Progress
+	}
+	return 0
+}
+
+func (x *Job) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *Job) GetResult() map[string]string {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *Job) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *Job) GetCreated() string {
+	if x != nil {
+		return x.Created
+	}
+	return ""
+}
+
+func (x *Job) GetUpdated() string {
+	if x != nil {
+		return x.Updated
+	}
+	return ""
+}
+
+func (x *Job) GetArtistIds() []string {
+	if x != nil {
+		return x.ArtistIds
+	}
+	return nil
+}
+
+type SubmitJobRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SiteId       uint32   `protobuf:"varint,1,opt,name=siteId,proto3" json:"siteId,omitempty"`
+	JobType      int32    `protobuf:"varint,2,opt,name=jobType,proto3" json:"jobType,omitempty"`
+	ArtistId     string   `protobuf:"bytes,3,opt,name=artistId,proto3" json:"artistId,omitempty"`
+	AlbumIds     []string `protobuf:"bytes,4,rep,name=albumIds,proto3" json:"albumIds,omitempty"`
+	TrackQuality string   `protobuf:"bytes,5,opt,name=trackQuality,proto3" json:"trackQuality,omitempty"`
+	IsPl         bool     `protobuf:"varint,6,opt,name=isPl,proto3" json:"isPl,omitempty"`
+	IsAdd        bool     `protobuf:"varint,7,opt,name=isAdd,proto3" json:"isAdd,omitempty"`
+	ArtistIds    []string `protobuf:"bytes,8,rep,name=artistIds,proto3" json:"artistIds,omitempty"`
+}
+
+func (x *SubmitJobRequest) Reset() {
+	*x = SubmitJobRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_artist_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubmitJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitJobRequest) ProtoMessage() {}
+
+func (x *SubmitJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_artist_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitJobRequest.ProtoReflect.Descriptor instead.
+func (*SubmitJobRequest) Descriptor() ([]byte, []int) {
+	return file_artist_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *SubmitJobRequest) GetSiteId() uint32 {
+	if x != nil {
+		return x.SiteId
+	}
+	return 0
+}
+
+func (x *SubmitJobRequest) GetJobType() int32 {
+	if x != nil {
+		return x.JobType
+	}
+	return 0
+}
+
+func (x *SubmitJobRequest) GetArtistId() string {
+	if x != nil {
+		return x.ArtistId
+	}
+	return ""
+}
+
+func (x *SubmitJobRequest) GetAlbumIds() []string {
+	if x != nil {
+		return x.AlbumIds
+	}
+	return nil
+}
+
+func (x *SubmitJobRequest) GetTrackQuality() string {
+	if x != nil {
+		return x.TrackQuality
+	}
+	return ""
+}
+
+func (x *SubmitJobRequest) GetIsPl() bool {
+	if x != nil {
+		return x.IsPl
+	}
+	return false
+}
+
+func (x *SubmitJobRequest) GetIsAdd() bool {
+	if x != nil {
+		return x.IsAdd
+	}
+	return false
+}
+
+func (x *SubmitJobRequest) GetArtistIds() []string {
+	if x != nil {
+		return x.ArtistIds
+	}
+	return nil
+}
+
+type JobRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JobId int64 `protobuf:"varint,1,opt,name=jobId,proto3" json:"jobId,omitempty"`
+}
+
+func (x *JobRequest) Reset() {
+	*x = JobRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_artist_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobRequest) ProtoMessage() {}
+
+func (x *JobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_artist_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobRequest.ProtoReflect.Descriptor instead.
+func (*JobRequest) Descriptor() ([]byte, []int) {
+	return file_artist_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *JobRequest) GetJobId() int64 {
+	if x != nil {
+		return x.JobId
+	}
+	return 0
+}
+
+// ids or links of artists (zvuk) or channels (youtube), result of each one is in Job.result:
+// added, existed, invalid or failed: <reason>
+type BulkAddArtistsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SiteId uint32   `protobuf:"varint,1,opt,name=siteId,proto3" json:"siteId,omitempty"`
+	Ids    []string `protobuf:"bytes,2,rep,name=ids,proto3" json:"ids,omitempty"`
+}
+
+func (x *BulkAddArtistsRequest) Reset() {
+	*x = BulkAddArtistsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_artist_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BulkAddArtistsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkAddArtistsRequest) ProtoMessage() {}
+
+func (x *BulkAddArtistsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_artist_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkAddArtistsRequest.ProtoReflect.Descriptor instead.
+func (*BulkAddArtistsRequest) Descriptor() ([]byte, []int) {
+	return file_artist_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *BulkAddArtistsRequest) GetSiteId() uint32 {
+	if x != nil {
+		return x.SiteId
+	}
+	return 0
+}
+
+func (x *BulkAddArtistsRequest) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type ListJobsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SiteId uint32  `protobuf:"varint,1,opt,name=siteId,proto3" json:"siteId,omitempty"`
+	States []int32 `protobuf:"varint,2,rep,packed,name=states,proto3" json:"states,omitempty"`
+	Limit  int32   `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *ListJobsRequest) Reset() {
+	*x = ListJobsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_artist_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListJobsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJobsRequest) ProtoMessage() {}
+
+func (x *ListJobsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_artist_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJobsRequest.ProtoReflect.Descriptor instead.
+func (*ListJobsRequest) Descriptor() ([]byte, []int) {
+	return file_artist_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ListJobsRequest) GetSiteId() uint32 {
+	if x != nil {
+		return x.SiteId
+	}
+	return 0
+}
+
+func (x *ListJobsRequest) GetStates() []int32 {
+	if x != nil {
+		return x.States
+	}
+	return nil
+}
+
+func (x *ListJobsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListJobsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Jobs []*Job `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
+}
+
+func (x *ListJobsResponse) Reset() {
+	*x = ListJobsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_artist_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListJobsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJobsResponse) ProtoMessage() {}
+
+func (x *ListJobsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_artist_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJobsResponse.ProtoReflect.Descriptor instead.
+func (*ListJobsResponse) Descriptor() ([]byte, []int) {
+	return file_artist_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ListJobsResponse) GetJobs() []*Job {
+	if x != nil {
+		return x.Jobs
+	}
+	return nil
+}
+
+type SearchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Query     string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	SiteId    uint32 `protobuf:"varint,2,opt,name=siteId,proto3" json:"siteId,omitempty"` // 0 - all sites
+	Limit     int32  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	MarkStart string `protobuf:"bytes,4,opt,name=markStart,proto3" json:"markStart,omitempty"` // highlight markers, default [ and ]
+	MarkEnd   string `protobuf:"bytes,5,opt,name=markEnd,proto3" json:"markEnd,omitempty"`
+}
+
+func (x *SearchRequest) Reset() {
+	*x = SearchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_artist_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchRequest) ProtoMessage() {}
+
+func (x *SearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_artist_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchRequest.ProtoReflect.Descriptor instead.
+func (*SearchRequest) Descriptor() ([]byte, []int) {
+	return file_artist_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *SearchRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetSiteId() uint32 {
+	if x != nil {
+		return x.SiteId
+	}
+	return 0
+}
+
+func (x *SearchRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *SearchRequest) GetMarkStart() string {
+	if x != nil {
+		return x.MarkStart
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetMarkEnd() string {
+	if x != nil {
+		return x.MarkEnd
+	}
+	return ""
+}
+
+type SearchResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 0 - artist, 1 - album, 2 - channel, 3 - video
+	Kind        int32   `protobuf:"varint,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	SiteId      uint32  `protobuf:"varint,2,opt,name=siteId,proto3" json:"siteId,omitempty"`
+	Id          string  `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
+	Title       string  `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	Snippet     string  `protobuf:"bytes,5,opt,name=snippet,proto3" json:"snippet,omitempty"`
+	ParentId    string  `protobuf:"bytes,6,opt,name=parentId,proto3" json:"parentId,omitempty"` // artist of the album, channel of the video
+	ParentTitle string  `protobuf:"bytes,7,opt,name=parentTitle,proto3" json:"parentTitle,omitempty"`
+	ReleaseDate string  `protobuf:"bytes,8,opt,name=releaseDate,proto3" json:"releaseDate,omitempty"`
+	Score       float64 `protobuf:"fixed64,9,opt,name=score,proto3" json:"score,omitempty"`
+}
+
+func (x *SearchResult) Reset() {
+	*x = SearchResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_artist_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchResult) ProtoMessage() {}
+
+func (x *SearchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_artist_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchResult.ProtoReflect.Descriptor instead.
+func (*SearchResult) Descriptor() ([]byte, []int) {
+	return file_artist_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *SearchResult) GetKind() int32 {
+	if x != nil {
+		return x.Kind
+	}
+	return 0
+}
+
+func (x *SearchResult) GetSiteId() uint32 {
+	if x != nil {
+		return x.SiteId
+	}
+	return 0
+}
+
+func (x *SearchResult) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SearchResult) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *SearchResult) GetSnippet() string {
+	if x != nil {
+		return x.Snippet
+	}
+	return ""
+}
+
+func (x *SearchResult) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *SearchResult) GetParentTitle() string {
+	if x != nil {
+		return x.ParentTitle
+	}
+	return ""
+}
+
+func (x *SearchResult) GetReleaseDate() string {
+	if x != nil {
+		return x.ReleaseDate
+	}
+	return ""
+}
+
+func (x *SearchResult) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+type SearchResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Results []*SearchResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *SearchResponse) Reset() {
+	*x = SearchResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_artist_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchResponse) ProtoMessage() {}
+
+func (x *SearchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_artist_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchResponse.ProtoReflect.Descriptor instead.
+func (*SearchResponse) Descriptor() ([]byte, []int) {
+	return file_artist_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *SearchResponse) GetResults() []*SearchResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type SuggestArtistsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SiteId uint32 `protobuf:"varint,1,opt,name=siteId,proto3" json:"siteId,omitempty"`
+	Limit  int32  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *SuggestArtistsRequest) Reset() {
+	*x = SuggestArtistsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_artist_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SuggestArtistsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestArtistsRequest) ProtoMessage() {}
+
+func (x *SuggestArtistsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_artist_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestArtistsRequest.ProtoReflect.Descriptor instead.
+func (*SuggestArtistsRequest) Descriptor() ([]byte, []int) {
+	return file_artist_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *SuggestArtistsRequest) GetSiteId() uint32 {
+	if x != nil {
+		return x.SiteId
+	}
+	return 0
+}
+
+func (x *SuggestArtistsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type SuggestedArtist struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Artist   *Artist  `protobuf:"bytes,1,opt,name=artist,proto3" json:"artist,omitempty"`
+	Score    float64  `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`     // shared releases, newer ones weigh more
+	Releases []*Album `protobuf:"bytes,3,rep,name=releases,proto3" json:"releases,omitempty"` // shared releases, subTitle holds our artists on it
+}
+
+func (x *SuggestedArtist) Reset() {
+	*x = SuggestedArtist{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_artist_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SuggestedArtist) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestedArtist) ProtoMessage() {}
+
+func (x *SuggestedArtist) ProtoReflect() protoreflect.Message {
+	mi := &file_artist_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestedArtist.ProtoReflect.Descriptor instead.
+func (*SuggestedArtist) Descriptor() ([]byte, []int) {
+	return file_artist_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *SuggestedArtist) GetArtist() *Artist {
+	if x != nil {
+		return x.Artist
+	}
+	return nil
+}
+
+func (x *SuggestedArtist) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *SuggestedArtist) GetReleases() []*Album {
+	if x != nil {
+		return x.Releases
+	}
+	return nil
+}
+
+type SuggestArtistsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Artists []*SuggestedArtist `protobuf:"bytes,1,rep,name=artists,proto3" json:"artists,omitempty"`
+}
+
+func (x *SuggestArtistsResponse) Reset() {
+	*x = SuggestArtistsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_artist_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SuggestArtistsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestArtistsResponse) ProtoMessage() {}
+
+func (x *SuggestArtistsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_artist_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestArtistsResponse.ProtoReflect.Descriptor instead.
+func (*SuggestArtistsResponse) Descriptor() ([]byte, []int) {
+	return file_artist_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *SuggestArtistsResponse) GetArtists() []*SuggestedArtist {
+	if x != nil {
+		return x.Artists
+	}
+	return nil
+}
+
+type RefreshVideoStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SiteId    uint32 `protobuf:"varint,1,opt,name=siteId,proto3" json:"siteId,omitempty"`
+	ChannelId string `protobuf:"bytes,2,opt,name=channelId,proto3" json:"channelId,omitempty"` // empty - all channels
+	Days      int32  `protobuf:"varint,3,opt,name=days,proto3" json:"days,omitempty"`          // videos newer than this, 0 - default of the server
+}
+
+func (x *RefreshVideoStatsRequest) Reset() {
+	*x = RefreshVideoStatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_artist_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RefreshVideoStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshVideoStatsRequest) ProtoMessage() {}
+
+func (x *RefreshVideoStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_artist_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshVideoStatsRequest.ProtoReflect.Descriptor instead.
+func (*RefreshVideoStatsRequest) Descriptor() ([]byte, []int) {
+	return file_artist_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *RefreshVideoStatsRequest) GetSiteId() uint32 {
+	if x != nil {
+		return x.SiteId
+	}
+	return 0
+}
+
+func (x *RefreshVideoStatsRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *RefreshVideoStatsRequest) GetDays() int32 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+type RefreshVideoStatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VideoCount int32 `protobuf:"varint,1,opt,name=videoCount,proto3" json:"videoCount,omitempty"`
+}
+
+func (x *RefreshVideoStatsResponse) Reset() {
+	*x = RefreshVideoStatsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_artist_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RefreshVideoStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshVideoStatsResponse) ProtoMessage() {}
+
+func (x *RefreshVideoStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_artist_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshVideoStatsResponse.ProtoReflect.Descriptor instead.
+func (*RefreshVideoStatsResponse) Descriptor() ([]byte, []int) {
+	return file_artist_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *RefreshVideoStatsResponse) GetVideoCount() int32 {
+	if x != nil {
+		return x.VideoCount
+	}
+	return 0
+}
+
+type VideoStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SiteId    uint32 `protobuf:"varint,1,opt,name=siteId,proto3" json:"siteId,omitempty"`
+	VideoId   string `protobuf:"bytes,2,opt,name=videoId,proto3" json:"videoId,omitempty"` // video or channel is required
+	ChannelId string `protobuf:"bytes,3,opt,name=channelId,proto3" json:"channelId,omitempty"`
+	Days      int32  `protobuf:"varint,4,opt,name=days,proto3" json:"days,omitempty"` // 0 - whole history
+}
+
+func (x *VideoStatsRequest) Reset() {
+	*x = VideoStatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_artist_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VideoStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VideoStatsRequest) ProtoMessage() {}
+
+func (x *VideoStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_artist_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VideoStatsRequest.ProtoReflect.Descriptor instead.
+func (*VideoStatsRequest) Descriptor() ([]byte, []int) {
+	return file_artist_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *VideoStatsRequest) GetSiteId() uint32 {
+	if x != nil {
+		return x.SiteId
+	}
+	return 0
+}
+
+func (x *VideoStatsRequest) GetVideoId() string {
+	if x != nil {
+		return x.VideoId
+	}
+	return ""
+}
+
+func (x *VideoStatsRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *VideoStatsRequest) GetDays() int32 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+type VideoStatsPoint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Taken        string `protobuf:"bytes,1,opt,name=taken,proto3" json:"taken,omitempty"` // reading time for a video, day for a channel
+	ViewCount    int64  `protobuf:"varint,2,opt,name=viewCount,proto3" json:"viewCount,omitempty"`
+	LikeCount    int64  `protobuf:"varint,3,opt,name=likeCount,proto3" json:"likeCount,omitempty"`
+	CommentCount int64  `protobuf:"varint,4,opt,name=commentCount,proto3" json:"commentCount,omitempty"`
+	VideoCount   int32  `protobuf:"varint,5,opt,name=videoCount,proto3" json:"videoCount,omitempty"` // videos read that day, channel only
+}
+
+func (x *VideoStatsPoint) Reset() {
+	*x = VideoStatsPoint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_artist_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VideoStatsPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VideoStatsPoint) ProtoMessage() {}
+
+func (x *VideoStatsPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_artist_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VideoStatsPoint.ProtoReflect.Descriptor instead.
+func (*VideoStatsPoint) Descriptor() ([]byte, []int) {
+	return file_artist_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *VideoStatsPoint) GetTaken() string {
+	if x != nil {
+		return x.Taken
+	}
+	return ""
+}
+
+func (x *VideoStatsPoint) GetViewCount() int64 {
+	if x != nil {
+		return x.ViewCount
+	}
+	return 0
+}
+
+func (x *VideoStatsPoint) GetLikeCount() int64 {
+	if x != nil {
+		return x.LikeCount
+	}
+	return 0
+}
+
+func (x *VideoStatsPoint) GetCommentCount() int64 {
+	if x != nil {
+		return x.CommentCount
+	}
+	return 0
+}
+
+func (x *VideoStatsPoint) GetVideoCount() int32 {
+	if x != nil {
+		return x.VideoCount
+	}
+	return 0
+}
+
+type VideoStatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Points []*VideoStatsPoint `protobuf:"bytes,1,rep,name=points,proto3" json:"points,omitempty"`
+}
+
+func (x *VideoStatsResponse) Reset() {
+	*x = VideoStatsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_artist_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VideoStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VideoStatsResponse) ProtoMessage() {}
+
+func (x *VideoStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_artist_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VideoStatsResponse.ProtoReflect.Descriptor instead.
+func (*VideoStatsResponse) Descriptor() ([]byte, []int) {
+	return file_artist_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *VideoStatsResponse) GetPoints() []*VideoStatsPoint {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+type SyncRun struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                int64          `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	SiteId            uint32         `protobuf:"varint,2,opt,name=siteId,proto3" json:"siteId,omitempty"`
+	ArtistId          string         `protobuf:"bytes,3,opt,name=artistId,proto3" json:"artistId,omitempty"` // -1 - all artists
+	Trigger           int32          `protobuf:"varint,4,opt,name=trigger,proto3" json:"trigger,omitempty"`  // 0 - rpc, 1 - stream, 2 - job, 3 - bulk add, 4 - schedule
+	State             int32          `protobuf:"varint,5,opt,name=state,proto3" json:"state,omitempty"`      // 0 - running, 1 - done, 2 - failed, 3 - interrupted
+	IsAdd             bool           `protobuf:"varint,6,opt,name=isAdd,proto3" json:"isAdd,omitempty"`
+	StartedAt         string         `protobuf:"bytes,7,opt,name=startedAt,proto3" json:"startedAt,omitempty"`
+	FinishedAt        string         `protobuf:"bytes,8,opt,name=finishedAt,proto3" json:"finishedAt,omitempty"`
+	DurationMs        int64          `protobuf:"varint,9,opt,name=durationMs,proto3" json:"durationMs,omitempty"`
+	ArtistCount       int32          `protobuf:"varint,10,opt,name=artistCount,proto3" json:"artistCount,omitempty"`
+	NewAlbumCount     int32          `protobuf:"varint,11,opt,name=newAlbumCount,proto3" json:"newAlbumCount,omitempty"`
+	RemovedAlbumCount int32          `protobuf:"varint,12,opt,name=removedAlbumCount,proto3" json:"removedAlbumCount,omitempty"`
+	NewArtistCount    int32          `protobuf:"varint,13,opt,name=newArtistCount,proto3" json:"newArtistCount,omitempty"`
+	FailedCount       int32          `protobuf:"varint,14,opt,name=failedCount,proto3" json:"failedCount,omitempty"`
+	Error             string         `protobuf:"bytes,15,opt,name=error,proto3" json:"error,omitempty"`
+	Items             []*SyncRunItem `protobuf:"bytes,16,rep,name=items,proto3" json:"items,omitempty"` // all of them in GetSyncRun, the filtered artist in ListSyncRuns
+}
+
+func (x *SyncRun) Reset() {
+	*x = SyncRun{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_artist_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SyncRun) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncRun) ProtoMessage() {}
+
+func (x *SyncRun) ProtoReflect() protoreflect.Message {
+	mi := &file_artist_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncRun.ProtoReflect.Descriptor instead.
+func (*SyncRun) Descriptor() ([]byte, []int) {
+	return file_artist_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *SyncRun) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SyncRun) GetSiteId() uint32 {
+	if x != nil {
+		return x.SiteId
+	}
+	return 0
+}
+
+func (x *SyncRun) GetArtistId() string {
+	if x != nil {
+		return x.ArtistId
+	}
+	return ""
+}
+
+func (x *SyncRun) GetTrigger() int32 {
+	if x != nil {
+		return x.Trigger
+	}
+	return 0
+}
+
+func (x *SyncRun) GetState() int32 {
+	if x != nil {
+		return x.State
+	}
+	return 0
+}
+
+func (x *SyncRun) GetIsAdd() bool {
+	if x != nil {
+		return x.IsAdd
+	}
+	return false
+}
+
+func (x *SyncRun) GetStartedAt() string {
+	if x != nil {
+		return x.StartedAt
+	}
+	return ""
+}
+
+func (x *SyncRun) GetFinishedAt() string {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return ""
+}
+
+func (x *SyncRun) GetDurationMs() int64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+func (x *SyncRun) GetArtistCount() int32 {
+	if x != nil {
+		return x.ArtistCount
+	}
+	return 0
+}
+
+func (x *SyncRun) GetNewAlbumCount() int32 {
+	if x != nil {
+		return x.NewAlbumCount
+	}
+	return 0
+}
+
+func (x *SyncRun) GetRemovedAlbumCount() int32 {
+	if x != nil {
+		return x.RemovedAlbumCount
+	}
+	return 0
+}
+
+func (x *SyncRun) GetNewArtistCount() int32 {
+	if x != nil {
+		return x.NewArtistCount
+	}
+	return 0
+}
+
+func (x *SyncRun) GetFailedCount() int32 {
+	if x != nil {
+		return x.FailedCount
+	}
+	return 0
+}
+
+func (x *SyncRun) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *SyncRun) GetItems() []*SyncRunItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type SyncRunItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ArtistId          string `protobuf:"bytes,1,opt,name=artistId,proto3" json:"artistId,omitempty"`
+	Title             string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	NewAlbumCount     int32  `protobuf:"varint,3,opt,name=newAlbumCount,proto3" json:"newAlbumCount,omitempty"`
+	RemovedAlbumCount int32  `protobuf:"varint,4,opt,name=removedAlbumCount,proto3" json:"removedAlbumCount,omitempty"`
+	NewArtistCount    int32  `protobuf:"varint,5,opt,name=newArtistCount,proto3" json:"newArtistCount,omitempty"`
+	Error             string `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	Reason            string `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`          // THROTTLED, QUOTA_EXCEEDED etc. when the provider failed
+	StatusCode        int32  `protobuf:"varint,8,opt,name=statusCode,proto3" json:"statusCode,omitempty"` // http status of the failed call
+	StartedAt         string `protobuf:"bytes,9,opt,name=startedAt,proto3" json:"startedAt,omitempty"`
+	DurationMs        int64  `protobuf:"varint,10,opt,name=durationMs,proto3" json:"durationMs,omitempty"`
+}
+
+func (x *SyncRunItem) Reset() {
+	*x = SyncRunItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_artist_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SyncRunItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncRunItem) ProtoMessage() {}
+
+func (x *SyncRunItem) ProtoReflect() protoreflect.Message {
+	mi := &file_artist_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncRunItem.ProtoReflect.Descriptor instead.
+func (*SyncRunItem) Descriptor() ([]byte, []int) {
+	return file_artist_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *SyncRunItem) GetArtistId() string {
+	if x != nil {
+		return x.ArtistId
+	}
+	return ""
+}
+
+func (x *SyncRunItem) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *SyncRunItem) GetNewAlbumCount() int32 {
+	if x != nil {
+		return x.NewAlbumCount
+	}
+	return 0
+}
+
+func (x *SyncRunItem) GetRemovedAlbumCount() int32 {
+	if x != nil {
+		return x.RemovedAlbumCount
+	}
+	return 0
+}
+
+func (x *SyncRunItem) GetNewArtistCount() int32 {
+	if x != nil {
+		return x.NewArtistCount
+	}
+	return 0
+}
+
+func (x *SyncRunItem) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *SyncRunItem) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *SyncRunItem) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *SyncRunItem) GetStartedAt() string {
+	if x != nil {
+		return x.StartedAt
+	}
+	return ""
+}
+
+func (x *SyncRunItem) GetDurationMs() int64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+type ListSyncRunsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SiteId     uint32 `protobuf:"varint,1,opt,name=siteId,proto3" json:"siteId,omitempty"`         // 0 - all sites
+	ArtistId   string `protobuf:"bytes,2,opt,name=artistId,proto3" json:"artistId,omitempty"`      // runs that synced this artist
+	FailedOnly bool   `protobuf:"varint,3,opt,name=failedOnly,proto3" json:"failedOnly,omitempty"` // runs with failures, or where the artist failed
+	Limit      int32  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`           // 0 - default of the server
+}
+
+func (x *ListSyncRunsRequest) Reset() {
+	*x = ListSyncRunsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_artist_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSyncRunsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSyncRunsRequest) ProtoMessage() {}
+
+func (x *ListSyncRunsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_artist_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSyncRunsRequest.ProtoReflect.Descriptor instead.
+func (*ListSyncRunsRequest) Descriptor() ([]byte, []int) {
+	return file_artist_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ListSyncRunsRequest) GetSiteId() uint32 {
+	if x != nil {
+		return x.SiteId
+	}
+	return 0
+}
+
+func (x *ListSyncRunsRequest) GetArtistId() string {
+	if x != nil {
+		return x.ArtistId
+	}
+	return ""
+}
+
+func (x *ListSyncRunsRequest) GetFailedOnly() bool {
+	if x != nil {
+		return x.FailedOnly
+	}
+	return false
+}
+
+func (x *ListSyncRunsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListSyncRunsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Runs []*SyncRun `protobuf:"bytes,1,rep,name=runs,proto3" json:"runs,omitempty"`
+}
+
+func (x *ListSyncRunsResponse) Reset() {
+	*x = ListSyncRunsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_artist_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSyncRunsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSyncRunsResponse) ProtoMessage() {}
+
+func (x *ListSyncRunsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_artist_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSyncRunsResponse.ProtoReflect.Descriptor instead.
+func (*ListSyncRunsResponse) Descriptor() ([]byte, []int) {
+	return file_artist_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ListSyncRunsResponse) GetRuns() []*SyncRun {
+	if x != nil {
+		return x.Runs
+	}
+	return nil
+}
+
+type SyncRunRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RunId      int64 `protobuf:"varint,1,opt,name=runId,proto3" json:"runId,omitempty"`
+	FailedOnly bool  `protobuf:"varint,2,opt,name=failedOnly,proto3" json:"failedOnly,omitempty"` // failed items only
+}
+
+func (x *SyncRunRequest) Reset() {
+	*x = SyncRunRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_artist_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SyncRunRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncRunRequest) ProtoMessage() {}
+
+func (x *SyncRunRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_artist_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncRunRequest.ProtoReflect.Descriptor instead.
+func (*SyncRunRequest) Descriptor() ([]byte, []int) {
+	return file_artist_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *SyncRunRequest) GetRunId() int64 {
+	if x != nil {
+		return x.RunId
+	}
+	return 0
+}
+
+func (x *SyncRunRequest) GetFailedOnly() bool {
+	if x != nil {
+		return x.FailedOnly
+	}
+	return false
+}
+
+type SyncSchedule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SiteId       uint32 `protobuf:"varint,1,opt,name=siteId,proto3" json:"siteId,omitempty"`
+	Spec         string `protobuf:"bytes,2,opt,name=spec,proto3" json:"spec,omitempty"` // cron expression or interval like 6h, empty - the site has no schedule
+	JitterSec    int32  `protobuf:"varint,3,opt,name=jitterSec,proto3" json:"jitterSec,omitempty"`
+	QuietHours   string `protobuf:"bytes,4,opt,name=quietHours,proto3" json:"quietHours,omitempty"` // local hours without scheduled syncs, like 1-7
+	NextRun      string `protobuf:"bytes,5,opt,name=nextRun,proto3" json:"nextRun,omitempty"`       // local time
+	NextRunIn    string `protobuf:"bytes,6,opt,name=nextRunIn,proto3" json:"nextRunIn,omitempty"`   // in 2 hours
+	Running      bool   `protobuf:"varint,7,opt,name=running,proto3" json:"running,omitempty"`
+	LastRun      string `protobuf:"bytes,8,opt,name=lastRun,proto3" json:"lastRun,omitempty"`
+	LastRunId    int64  `protobuf:"varint,9,opt,name=lastRunId,proto3" json:"lastRunId,omitempty"`        // entry of the sync journal
+	SkippedCount int32  `protobuf:"varint,10,opt,name=skippedCount,proto3" json:"skippedCount,omitempty"` // runs skipped while the previous sync was still going
+}
+
+func (x *SyncSchedule) Reset() {
+	*x = SyncSchedule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_artist_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SyncSchedule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncSchedule) ProtoMessage() {}
+
+func (x *SyncSchedule) ProtoReflect() protoreflect.Message {
+	mi := &file_artist_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncSchedule.ProtoReflect.Descriptor instead.
+func (*SyncSchedule) Descriptor() ([]byte, []int) {
+	return file_artist_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *SyncSchedule) GetSiteId() uint32 {
+	if x != nil {
+		return x.SiteId
+	}
+	return 0
+}
+
+func (x *SyncSchedule) GetSpec() string {
+	if x != nil {
+		return x.Spec
+	}
+	return ""
+}
+
+func (x *SyncSchedule) GetJitterSec() int32 {
+	if x != nil {
+		return x.JitterSec
+	}
+	return 0
+}
+
+func (x *SyncSchedule) GetQuietHours() string {
+	if x != nil {
+		return x.QuietHours
+	}
+	return ""
+}
+
+func (x *SyncSchedule) GetNextRun() string {
+	if x != nil {
+		return x.NextRun
+	}
+	return ""
+}
+
+func (x *SyncSchedule) GetNextRunIn() string {
+	if x != nil {
+		return x.NextRunIn
+	}
+	return ""
+}
+
+func (x *SyncSchedule) GetRunning() bool {
+	if x != nil {
+		return x.Running
+	}
+	return false
+}
+
+func (x *SyncSchedule) GetLastRun() string {
+	if x != nil {
+		return x.LastRun
+	}
+	return ""
+}
+
+func (x *SyncSchedule) GetLastRunId() int64 {
+	if x != nil {
+		return x.LastRunId
+	}
+	return 0
+}
+
+func (x *SyncSchedule) GetSkippedCount() int32 {
+	if x != nil {
+		return x.SkippedCount
+	}
+	return 0
+}
+
+type SyncScheduleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SiteId uint32 `protobuf:"varint,1,opt,name=siteId,proto3" json:"siteId,omitempty"` // 0 - all sites
+}
+
+func (x *SyncScheduleRequest) Reset() {
+	*x = SyncScheduleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_artist_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SyncScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncScheduleRequest) ProtoMessage() {}
+
+func (x *SyncScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_artist_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncScheduleRequest.ProtoReflect.Descriptor instead.
+func (*SyncScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_artist_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *SyncScheduleRequest) GetSiteId() uint32 {
+	if x != nil {
+		return x.SiteId
+	}
+	return 0
+}
+
+type SyncScheduleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Schedules []*SyncSchedule `protobuf:"bytes,1,rep,name=schedules,proto3" json:"schedules,omitempty"`
+}
+
+func (x *SyncScheduleResponse) Reset() {
+	*x = SyncScheduleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_artist_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SyncScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncScheduleResponse) ProtoMessage() {}
+
+func (x *SyncScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_artist_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncScheduleResponse.ProtoReflect.Descriptor instead.
+func (*SyncScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_artist_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *SyncScheduleResponse) GetSchedules() []*SyncSchedule {
+	if x != nil {
+		return x.Schedules
+	}
+	return nil
+}
+
+type DownloadRule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id           int64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	SiteId       uint32  `protobuf:"varint,2,opt,name=siteId,proto3" json:"siteId,omitempty"`
+	ArtistId     string  `protobuf:"bytes,3,opt,name=artistId,proto3" json:"artistId,omitempty"`                 // empty - every artist of the site, artist rules are checked first
+	ReleaseTypes []int32 `protobuf:"varint,4,rep,packed,name=releaseTypes,proto3" json:"releaseTypes,omitempty"` // 0 album, 1 single, 3 video, 4 compilation, empty - all
+	Kinds        []int32 `protobuf:"varint,5,rep,packed,name=kinds,proto3" json:"kinds,omitempty"`               // video kinds, empty - all
+	Action       int32   `protobuf:"varint,6,opt,name=action,proto3" json:"action,omitempty"`                    // 0 - download, 1 - ignore
+	Quality      string  `protobuf:"bytes,7,opt,name=quality,proto3" json:"quality,omitempty"`                   // mid, high, flac for zvuk, yt-dlp format for youtube
+	Enabled      bool    `protobuf:"varint,8,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Created      string  `protobuf:"bytes,9,opt,name=created,proto3" json:"created,omitempty"`
+}
+
+func (x *DownloadRule) Reset() {
+	*x = DownloadRule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_artist_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DownloadRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadRule) ProtoMessage() {}
+
+func (x *DownloadRule) ProtoReflect() protoreflect.Message {
+	mi := &file_artist_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadRule.ProtoReflect.Descriptor instead.
+func (*DownloadRule) Descriptor() ([]byte, []int) {
+	return file_artist_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *DownloadRule) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DownloadRule) GetSiteId() uint32 {
+	if x != nil {
+		return x.SiteId
+	}
+	return 0
+}
+
+func (x *DownloadRule) GetArtistId() string {
+	if x != nil {
+		return x.ArtistId
+	}
+	return ""
+}
+
+func (x *DownloadRule) GetReleaseTypes() []int32 {
+	if x != nil {
+		return x.ReleaseTypes
+	}
+	return nil
+}
+
+func (x *DownloadRule) GetKinds() []int32 {
+	if x != nil {
+		return x.Kinds
+	}
+	return nil
+}
+
+func (x *DownloadRule) GetAction() int32 {
+	if x != nil {
+		return x.Action
+	}
+	return 0
+}
+
+func (x *DownloadRule) GetQuality() string {
+	if x != nil {
+		return x.Quality
+	}
+	return ""
+}
+
+func (x *DownloadRule) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *DownloadRule) GetCreated() string {
+	if x != nil {
+		return x.Created
+	}
+	return ""
+}
+
+type DownloadRuleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RuleId int64 `protobuf:"varint,1,opt,name=ruleId,proto3" json:"ruleId,omitempty"`
+}
+
+func (x *DownloadRuleRequest) Reset() {
+	*x = DownloadRuleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_artist_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DownloadRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadRuleRequest) ProtoMessage() {}
+
+func (x *DownloadRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_artist_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadRuleRequest.ProtoReflect.Descriptor instead.
+func (*DownloadRuleRequest) Descriptor() ([]byte, []int) {
+	return file_artist_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *DownloadRuleRequest) GetRuleId() int64 {
+	if x != nil {
+		return x.RuleId
+	}
+	return 0
+}
+
+type ListDownloadRulesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SiteId   uint32 `protobuf:"varint,1,opt,name=siteId,proto3" json:"siteId,omitempty"` // 0 - all sites
+	ArtistId string `protobuf:"bytes,2,opt,name=artistId,proto3" json:"artistId,omitempty"`
+}
+
+func (x *ListDownloadRulesRequest) Reset() {
+	*x = ListDownloadRulesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_artist_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDownloadRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDownloadRulesRequest) ProtoMessage() {}
+
+func (x *ListDownloadRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_artist_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDownloadRulesRequest.ProtoReflect.Descriptor instead.
+func (*ListDownloadRulesRequest) Descriptor() ([]byte, []int) {
+	return file_artist_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ListDownloadRulesRequest) GetSiteId() uint32 {
+	if x != nil {
+		return x.SiteId
+	}
+	return 0
+}
+
+func (x *ListDownloadRulesRequest) GetArtistId() string {
+	if x != nil {
+		return x.ArtistId
+	}
+	return ""
+}
+
+type ListDownloadRulesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rules []*DownloadRule `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+}
+
+func (x *ListDownloadRulesResponse) Reset() {
+	*x = ListDownloadRulesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_artist_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDownloadRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDownloadRulesResponse) ProtoMessage() {}
+
+func (x *ListDownloadRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_artist_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDownloadRulesResponse.ProtoReflect.Descriptor instead.
+func (*ListDownloadRulesResponse) Descriptor() ([]byte, []int) {
+	return file_artist_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ListDownloadRulesResponse) GetRules() []*DownloadRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+type DeleteDownloadRuleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RowsAffected int64 `protobuf:"varint,1,opt,name=rowsAffected,proto3" json:"rowsAffected,omitempty"`
+}
+
+func (x *DeleteDownloadRuleResponse) Reset() {
+	*x = DeleteDownloadRuleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_artist_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteDownloadRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteDownloadRuleResponse) ProtoMessage() {}
+
+func (x *DeleteDownloadRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_artist_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteDownloadRuleResponse.ProtoReflect.Descriptor instead.
+func (*DeleteDownloadRuleResponse) Descriptor() ([]byte, []int) {
+	return file_artist_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *DeleteDownloadRuleResponse) GetRowsAffected() int64 {
+	if x != nil {
+		return x.RowsAffected
+	}
+	return 0
+}
+
+type PreviewDownloadRulesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SiteId   uint32          `protobuf:"varint,1,opt,name=siteId,proto3" json:"siteId,omitempty"`
+	ArtistId string          `protobuf:"bytes,2,opt,name=artistId,proto3" json:"artistId,omitempty"` // empty - new releases of all artists
+	Rules    []*DownloadRule `protobuf:"bytes,3,rep,name=rules,proto3" json:"rules,omitempty"`       // draft rules to try instead of the stored ones
+}
+
+func (x *PreviewDownloadRulesRequest) Reset() {
+	*x = PreviewDownloadRulesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_artist_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PreviewDownloadRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewDownloadRulesRequest) ProtoMessage() {}
+
+func (x *PreviewDownloadRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_artist_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewDownloadRulesRequest.ProtoReflect.Descriptor instead.
+func (*PreviewDownloadRulesRequest) Descriptor() ([]byte, []int) {
+	return file_artist_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *PreviewDownloadRulesRequest) GetSiteId() uint32 {
+	if x != nil {
+		return x.SiteId
+	}
+	return 0
+}
+
+func (x *PreviewDownloadRulesRequest) GetArtistId() string {
+	if x != nil {
+		return x.ArtistId
+	}
+	return ""
+}
+
+func (x *PreviewDownloadRulesRequest) GetRules() []*DownloadRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+type DownloadDecision struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ArtistId string `protobuf:"bytes,1,opt,name=artistId,proto3" json:"artistId,omitempty"`
+	Album    *Album `protobuf:"bytes,2,opt,name=album,proto3" json:"album,omitempty"`
+	RuleId   int64  `protobuf:"varint,3,opt,name=ruleId,proto3" json:"ruleId,omitempty"` // 0 for a draft rule
+	Action   int32  `protobuf:"varint,4,opt,name=action,proto3" json:"action,omitempty"`
+	Quality  string `protobuf:"bytes,5,opt,name=quality,proto3" json:"quality,omitempty"`
+}
+
+func (x *DownloadDecision) Reset() {
+	*x = DownloadDecision{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_artist_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DownloadDecision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadDecision) ProtoMessage() {}
+
+func (x *DownloadDecision) ProtoReflect() protoreflect.Message {
+	mi := &file_artist_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadDecision.ProtoReflect.Descriptor instead.
+func (*DownloadDecision) Descriptor() ([]byte, []int) {
+	return file_artist_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *DownloadDecision) GetArtistId() string {
+	if x != nil {
+		return x.ArtistId
+	}
+	return ""
+}
+
+func (x *DownloadDecision) GetAlbum() *Album {
+	if x != nil {
+		return x.Album
+	}
+	return nil
+}
+
+func (x *DownloadDecision) GetRuleId() int64 {
+	if x != nil {
+		return x.RuleId
+	}
+	return 0
+}
+
+func (x *DownloadDecision) GetAction() int32 {
+	if x != nil {
+		return x.Action
+	}
+	return 0
+}
+
+func (x *DownloadDecision) GetQuality() string {
+	if x != nil {
+		return x.Quality
+	}
+	return ""
+}
+
+type PreviewDownloadRulesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Decisions []*DownloadDecision `protobuf:"bytes,1,rep,name=decisions,proto3" json:"decisions,omitempty"` // releases no rule matched are left out
+}
+
+func (x *PreviewDownloadRulesResponse) Reset() {
+	*x = PreviewDownloadRulesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_artist_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PreviewDownloadRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewDownloadRulesResponse) ProtoMessage() {}
+
+func (x *PreviewDownloadRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_artist_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewDownloadRulesResponse.ProtoReflect.Descriptor instead.
+func (*PreviewDownloadRulesResponse) Descriptor() ([]byte, []int) {
+	return file_artist_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *PreviewDownloadRulesResponse) GetDecisions() []*DownloadDecision {
+	if x != nil {
+		return x.Decisions
+	}
+	return nil
+}
+
+type SetSyncSettingsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SiteId   uint32        `protobuf:"varint,1,opt,name=siteId,proto3" json:"siteId,omitempty"`
+	ArtistId string        `protobuf:"bytes,2,opt,name=artistId,proto3" json:"artistId,omitempty"`
+	Settings *SyncSettings `protobuf:"bytes,3,opt,name=settings,proto3" json:"settings,omitempty"`
+}
+
+func (x *SetSyncSettingsRequest) Reset() {
+	*x = SetSyncSettingsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_artist_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetSyncSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSyncSettingsRequest) ProtoMessage() {}
+
+func (x *SetSyncSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_artist_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSyncSettingsRequest.ProtoReflect.Descriptor instead.
+func (*SetSyncSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_artist_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *SetSyncSettingsRequest) GetSiteId() uint32 {
+	if x != nil {
+		return x.SiteId
+	}
+	return 0
+}
+
+func (x *SetSyncSettingsRequest) GetArtistId() string {
+	if x != nil {
+		return x.ArtistId
+	}
+	return ""
+}
+
+func (x *SetSyncSettingsRequest) GetSettings() *SyncSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+type Site struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SiteId  uint32 `protobuf:"varint,1,opt,name=siteId,proto3" json:"siteId,omitempty"`
+	Title   string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Login   string `protobuf:"bytes,3,opt,name=login,proto3" json:"login,omitempty"`
+	HasPass bool   `protobuf:"varint,4,opt,name=hasPass,proto3" json:"hasPass,omitempty"`
+	Token   string `protobuf:"bytes,5,opt,name=token,proto3" json:"token,omitempty"` // masked, only the edges are visible
+}
+
+func (x *Site) Reset() {
+	*x = Site{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_artist_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Site) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Site) ProtoMessage() {}
+
+func (x *Site) ProtoReflect() protoreflect.Message {
+	mi := &file_artist_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Site.ProtoReflect.Descriptor instead.
+func (*Site) Descriptor() ([]byte, []int) {
+	return file_artist_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *Site) GetSiteId() uint32 {
+	if x != nil {
+		return x.SiteId
+	}
+	return 0
+}
+
+func (x *Site) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Site) GetLogin() string {
+	if x != nil {
+		return x.Login
+	}
+	return ""
+}
+
+func (x *Site) GetHasPass() bool {
+	if x != nil {
+		return x.HasPass
+	}
+	return false
+}
+
+func (x *Site) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type ListSitesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListSitesRequest) Reset() {
+	*x = ListSitesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_artist_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSitesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSitesRequest) ProtoMessage() {}
+
+func (x *ListSitesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_artist_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSitesRequest.ProtoReflect.Descriptor instead.
+func (*ListSitesRequest) Descriptor() ([]byte, []int) {
+	return file_artist_proto_rawDescGZIP(), []int{65}
+}
+
+type ListSitesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sites []*Site `protobuf:"bytes,1,rep,name=sites,proto3" json:"sites,omitempty"`
+}
+
+func (x *ListSitesResponse) Reset() {
+	*x = ListSitesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_artist_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSitesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSitesResponse) ProtoMessage() {}
+
+func (x *ListSitesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_artist_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSitesResponse.ProtoReflect.Descriptor instead.
+func (*ListSitesResponse) Descriptor() ([]byte, []int) {
+	return file_artist_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *ListSitesResponse) GetSites() []*Site {
+	if x != nil {
+		return x.Sites
+	}
+	return nil
+}
+
+type UpdateSiteCredentialsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SiteId uint32 `protobuf:"varint,1,opt,name=siteId,proto3" json:"siteId,omitempty"`
+	// not set - keep the stored value, empty - clear it
+	Login *string `protobuf:"bytes,2,opt,name=login,proto3,oneof" json:"login,omitempty"`
+	Pass  *string `protobuf:"bytes,3,opt,name=pass,proto3,oneof" json:"pass,omitempty"`
+	Token *string `protobuf:"bytes,4,opt,name=token,proto3,oneof" json:"token,omitempty"`
+}
+
+func (x *UpdateSiteCredentialsRequest) Reset() {
+	*x = UpdateSiteCredentialsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_artist_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateSiteCredentialsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSiteCredentialsRequest) ProtoMessage() {}
+
+func (x *UpdateSiteCredentialsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_artist_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSiteCredentialsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSiteCredentialsRequest) Descriptor() ([]byte, []int) {
+	return file_artist_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *UpdateSiteCredentialsRequest) GetSiteId() uint32 {
+	if x != nil {
+		return x.SiteId
+	}
+	return 0
+}
+
+func (x *UpdateSiteCredentialsRequest) GetLogin() string {
+	if x != nil && x.Login != nil {
+		return *x.Login
+	}
+	return ""
+}
+
+func (x *UpdateSiteCredentialsRequest) GetPass() string {
+	if x != nil && x.Pass != nil {
+		return *x.Pass
+	}
+	return ""
+}
+
+func (x *UpdateSiteCredentialsRequest) GetToken() string {
+	if x != nil && x.Token != nil {
+		return *x.Token
+	}
+	return ""
+}
+
+type ValidateSiteCredentialsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SiteId uint32  `protobuf:"varint,1,opt,name=siteId,proto3" json:"siteId,omitempty"`
+	Token  *string `protobuf:"bytes,2,opt,name=token,proto3,oneof" json:"token,omitempty"` // check this token instead of the stored one
+}
+
+func (x *ValidateSiteCredentialsRequest) Reset() {
+	*x = ValidateSiteCredentialsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_artist_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidateSiteCredentialsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateSiteCredentialsRequest) ProtoMessage() {}
+
+func (x *ValidateSiteCredentialsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_artist_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateSiteCredentialsRequest.ProtoReflect.Descriptor instead.
+func (*ValidateSiteCredentialsRequest) Descriptor() ([]byte, []int) {
+	return file_artist_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *ValidateSiteCredentialsRequest) GetSiteId() uint32 {
+	if x != nil {
+		return x.SiteId
+	}
+	return 0
+}
+
+func (x *ValidateSiteCredentialsRequest) GetToken() string {
+	if x != nil && x.Token != nil {
+		return *x.Token
+	}
+	return ""
+}
+
+type ValidateSiteCredentialsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Valid   bool   `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	Reason  string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"` // TOKEN_EXPIRED, THROTTLED etc. when not valid
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *ValidateSiteCredentialsResponse) Reset() {
+	*x = ValidateSiteCredentialsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_artist_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidateSiteCredentialsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateSiteCredentialsResponse) ProtoMessage() {}
+
+func (x *ValidateSiteCredentialsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_artist_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateSiteCredentialsResponse.ProtoReflect.Descriptor instead.
+func (*ValidateSiteCredentialsResponse) Descriptor() ([]byte, []int) {
+	return file_artist_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *ValidateSiteCredentialsResponse) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *ValidateSiteCredentialsResponse) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ValidateSiteCredentialsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type QuotaRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SiteId uint32 `protobuf:"varint,1,opt,name=siteId,proto3" json:"siteId,omitempty"`
+	Days   int32  `protobuf:"varint,2,opt,name=days,proto3" json:"days,omitempty"` // history depth, 0 - today only
+}
+
+func (x *QuotaRequest) Reset() {
+	*x = QuotaRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_artist_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuotaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuotaRequest) ProtoMessage() {}
+
+func (x *QuotaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_artist_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuotaRequest.ProtoReflect.Descriptor instead.
+func (*QuotaRequest) Descriptor() ([]byte, []int) {
+	return file_artist_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *QuotaRequest) GetSiteId() uint32 {
+	if x != nil {
+		return x.SiteId
+	}
+	return 0
+}
+
+func (x *QuotaRequest) GetDays() int32 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+type QuotaUsage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Day    string `protobuf:"bytes,1,opt,name=day,proto3" json:"day,omitempty"` // pacific date, google resets the quota at its midnight
+	Method string `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
+	Units  int64  `protobuf:"varint,3,opt,name=units,proto3" json:"units,omitempty"`
+	Calls  int64  `protobuf:"varint,4,opt,name=calls,proto3" json:"calls,omitempty"`
+}
+
+func (x *QuotaUsage) Reset() {
+	*x = QuotaUsage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_artist_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuotaUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuotaUsage) ProtoMessage() {}
+
+func (x *QuotaUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_artist_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuotaUsage.ProtoReflect.Descriptor instead.
+func (*QuotaUsage) Descriptor() ([]byte, []int) {
+	return file_artist_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *QuotaUsage) GetDay() string {
+	if x != nil {
+		return x.Day
+	}
+	return ""
+}
+
+func (x *QuotaUsage) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *QuotaUsage) GetUnits() int64 {
+	if x != nil {
+		return x.Units
+	}
+	return 0
+}
+
+func (x *QuotaUsage) GetCalls() int64 {
+	if x != nil {
+		return x.Calls
+	}
+	return 0
+}
+
+type QuotaResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Day     string        `protobuf:"bytes,1,opt,name=day,proto3" json:"day,omitempty"`
+	Used    int64         `protobuf:"varint,2,opt,name=used,proto3" json:"used,omitempty"`
+	Budget  int64         `protobuf:"varint,3,opt,name=budget,proto3" json:"budget,omitempty"`
+	ResetAt string        `protobuf:"bytes,4,opt,name=resetAt,proto3" json:"resetAt,omitempty"`
+	Usage   []*QuotaUsage `protobuf:"bytes,5,rep,name=usage,proto3" json:"usage,omitempty"` // newest day first
+}
+
+func (x *QuotaResponse) Reset() {
+	*x = QuotaResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_artist_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuotaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuotaResponse) ProtoMessage() {}
+
+func (x *QuotaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_artist_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuotaResponse.ProtoReflect.Descriptor instead.
+func (*QuotaResponse) Descriptor() ([]byte, []int) {
+	return file_artist_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *QuotaResponse) GetDay() string {
+	if x != nil {
+		return x.Day
+	}
+	return ""
+}
+
+func (x *QuotaResponse) GetUsed() int64 {
+	if x != nil {
+		return x.Used
+	}
+	return 0
+}
+
+func (x *QuotaResponse) GetBudget() int64 {
+	if x != nil {
+		return x.Budget
+	}
+	return 0
+}
+
+func (x *QuotaResponse) GetResetAt() string {
+	if x != nil {
+		return x.ResetAt
+	}
+	return ""
+}
+
+func (x *QuotaResponse) GetUsage() []*QuotaUsage {
+	if x != nil {
+		return x.Usage
+	}
+	return nil
+}
+
+var File_artist_proto protoreflect.FileDescriptor
+
+var file_artist_proto_rawDesc = []byte{
+	0x0a, 0x0c, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06,
+	0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x22, 0xd9, 0x02, 0x0a, 0x06, 0x41, 0x72, 0x74, 0x69, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x69, 0x74, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x06, 0x73, 0x69, 0x74, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x72, 0x74,
+	0x69, 0x73, 0x74, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x72, 0x74,
+	0x69, 0x73, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74,
+	0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09,
+	0x74, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x73, 0x65,
+	0x72, 0x41, 0x64, 0x64, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x75, 0x73,
+	0x65, 0x72, 0x41, 0x64, 0x64, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x77, 0x41, 0x6c,
+	0x62, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6e, 0x65, 0x77, 0x41, 0x6c, 0x62,
+	0x73, 0x12, 0x25, 0x0a, 0x06, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0d, 0x2e, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x2e, 0x41, 0x6c, 0x62, 0x75, 0x6d,
+	0x52, 0x06, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x73, 0x12, 0x2e, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79,
+	0x6c, 0x69, 0x73, 0x74, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61, 0x72,
+	0x74, 0x69, 0x73, 0x74, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x09, 0x70,
+	0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x64, 0x41, 0x6c, 0x62, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x6c, 0x62, 0x12, 0x28, 0x0a, 0x04, 0x73, 0x79, 0x6e, 0x63,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x2e,
+	0x53, 0x79, 0x6e, 0x63, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x04, 0x73, 0x79,
+	0x6e, 0x63, 0x22, 0x84, 0x01, 0x0a, 0x0c, 0x53, 0x79, 0x6e, 0x63, 0x53, 0x65, 0x74, 0x74, 0x69,
+	0x6e, 0x67, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x48, 0x6f, 0x75, 0x72,
+	0x73, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x1a, 0x0a,
+	0x08, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x22, 0xc7, 0x03, 0x0a, 0x05, 0x41, 0x6c,
+	0x62, 0x75, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x49, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x49, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69,
+	0x74, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x75, 0x62, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x75, 0x62, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12,
+	0x20, 0x0a, 0x0b, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x61, 0x74, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x61, 0x74,
+	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x1c, 0x0a, 0x09, 0x76, 0x69, 0x65, 0x77, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x76, 0x69, 0x65, 0x77, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x1c, 0x0a, 0x09, 0x74, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x09, 0x74, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x12, 0x1c, 0x0a,
+	0x09, 0x73, 0x79, 0x6e, 0x63, 0x53, 0x74, 0x61, 0x74, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x09, 0x73, 0x79, 0x6e, 0x63, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6c,
+	0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09,
+	0x6c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x77, 0x61, 0x74,
+	0x63, 0x68, 0x53, 0x74, 0x61, 0x74, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x77,
+	0x61, 0x74, 0x63, 0x68, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x72, 0x74,
+	0x69, 0x73, 0x74, 0x49, 0x64, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x61, 0x72,
+	0x74, 0x69, 0x73, 0x74, 0x49, 0x64, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x71, 0x75, 0x61, 0x6c, 0x69,
+	0x74, 0x79, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x02, 0x52, 0x07, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x74,
+	0x79, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0f,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x10, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x6b,
+	0x69, 0x6e, 0x64, 0x22, 0x87, 0x02, 0x0a, 0x05, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x12, 0x18, 0x0a,
+	0x07, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x74, 0x72, 0x61, 0x63, 0x6b, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x64, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x67, 0x65, 0x6e, 0x72, 0x65, 0x73, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x67, 0x65, 0x6e, 0x72, 0x65, 0x73, 0x12, 0x18, 0x0a,
+	0x07, 0x68, 0x61, 0x73, 0x46, 0x6c, 0x61, 0x63, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x68, 0x61, 0x73, 0x46, 0x6c, 0x61, 0x63, 0x12, 0x26, 0x0a, 0x0e, 0x68, 0x69, 0x67, 0x68, 0x65,
+	0x73, 0x74, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0e, 0x68, 0x69, 0x67, 0x68, 0x65, 0x73, 0x74, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x12,
+	0x1a, 0x0a, 0x08, 0x65, 0x78, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x08, 0x65, 0x78, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x61,
+	0x72, 0x74, 0x69, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0b, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0xae, 0x01,
+	0x0a, 0x08, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x6c,
+	0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x70, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69,
+	0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65,
+	0x12, 0x1c, 0x0a, 0x09, 0x74, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x09, 0x74, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x12, 0x22,
+	0x0a, 0x0c, 0x70, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x70, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x64, 0x73, 0x18, 0x06,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x64, 0x73, 0x22, 0x5d,
+	0x0a, 0x11, 0x53, 0x79, 0x6e, 0x63, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x69, 0x74, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x06, 0x73, 0x69, 0x74, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x61,
+	0x72, 0x74, 0x69, 0x73, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61,
+	0x72, 0x74, 0x69, 0x73, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x73, 0x41, 0x64, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x69, 0x73, 0x41, 0x64, 0x64, 0x22, 0x3e, 0x0a,
+	0x12, 0x53, 0x79, 0x6e, 0x63, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x07, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x2e, 0x41, 0x72,
+	0x74, 0x69, 0x73, 0x74, 0x52, 0x07, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x73, 0x22, 0xc5, 0x02,
+	0x0a, 0x0f, 0x53, 0x79, 0x6e, 0x63, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x69, 0x74, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x06, 0x73, 0x69, 0x74, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x72, 0x74, 0x69, 0x73,
+	0x74, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x72, 0x74, 0x69, 0x73,
+	0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x25, 0x0a, 0x06, 0x61, 0x6c, 0x62,
+	0x75, 0x6d, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x61, 0x72, 0x74, 0x69,
+	0x73, 0x74, 0x2e, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x52, 0x06, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x73,
+	0x12, 0x28, 0x0a, 0x07, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x73,
+	0x74, 0x52, 0x07, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x12, 0x33, 0x0a, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x41,
+	0x72, 0x74, 0x69, 0x73, 0x74, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x07, 0x73, 0x75,
+	0x6d, 0x6d, 0x61, 0x72, 0x79, 0x22, 0xe1, 0x02, 0x0a, 0x11, 0x53, 0x79, 0x6e, 0x63, 0x41, 0x72,
+	0x74, 0x69, 0x73, 0x74, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x61,
+	0x72, 0x74, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0b, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x20, 0x0a,
+	0x0b, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0b, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x24, 0x0a, 0x0d, 0x6e, 0x65, 0x77, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x6e, 0x65, 0x77, 0x41, 0x6c, 0x62, 0x75, 0x6d,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x6e, 0x65, 0x77, 0x41, 0x72, 0x74, 0x69,
+	0x73, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x6e,
+	0x65, 0x77, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2a, 0x0a,
+	0x10, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x49, 0x64,
+	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64,
+	0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x49, 0x64, 0x73, 0x12, 0x2c, 0x0a, 0x11, 0x72, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x64, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x41, 0x6c, 0x62,
+	0x75, 0x6d, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x75, 0x6e, 0x49, 0x64,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x72, 0x75, 0x6e, 0x49, 0x64, 0x12, 0x26, 0x0a,
+	0x0e, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x4a, 0x6f, 0x62, 0x49, 0x64, 0x73, 0x18,
+	0x08, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0e, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x4a,
+	0x6f, 0x62, 0x49, 0x64, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x73, 0x6b, 0x69,
+	0x70, 0x70, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xdc, 0x02, 0x0a, 0x16, 0x52, 0x65,
+	0x61, 0x64, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x69, 0x74, 0x65, 0x49, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x73, 0x69, 0x74, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08,
+	0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x77, 0x4f,
+	0x6e, 0x6c, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x6e, 0x65, 0x77, 0x4f, 0x6e,
+	0x6c, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x22, 0x0a, 0x0c,
+	0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x54, 0x79, 0x70, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03,
+	0x28, 0x05, 0x52, 0x0c, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x54, 0x79, 0x70, 0x65, 0x73,
+	0x12, 0x1a, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x64, 0x61, 0x74, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x16, 0x0a, 0x06,
+	0x64, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x61,
+	0x74, 0x65, 0x54, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x6f, 0x72, 0x74, 0x4b, 0x65, 0x79, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x73, 0x6f, 0x72, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x18,
+	0x0a, 0x07, 0x73, 0x6f, 0x72, 0x74, 0x41, 0x73, 0x63, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x73, 0x6f, 0x72, 0x74, 0x41, 0x73, 0x63, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6b, 0x69, 0x6e, 0x64, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28,
+	0x05, 0x52, 0x05, 0x6b, 0x69, 0x6e, 0x64, 0x73, 0x22, 0x9a, 0x01, 0x0a, 0x17, 0x52, 0x65, 0x61,
+	0x64, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x08, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x2e,
+	0x41, 0x6c, 0x62, 0x75, 0x6d, 0x52, 0x08, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x12,
+	0x2e, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x2e, 0x50, 0x6c, 0x61, 0x79,
+	0x6c, 0x69, 0x73, 0x74, 0x52, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x73, 0x12,
+	0x24, 0x0a, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x4a, 0x0a, 0x16, 0x52, 0x65, 0x61, 0x64, 0x41, 0x6c, 0x62,
+	0x75, 0x6d, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x69, 0x74, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x06, 0x73, 0x69, 0x74, 0x65, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x6c, 0x62, 0x75, 0x6d,
+	0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x49,
+	0x64, 0x22, 0x40, 0x0a, 0x17, 0x52, 0x65, 0x61, 0x64, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x54, 0x72,
+	0x61, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x06,
+	0x74, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x61,
+	0x72, 0x74, 0x69, 0x73, 0x74, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x52, 0x06, 0x74, 0x72, 0x61,
+	0x63, 0x6b, 0x73, 0x22, 0x49, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x72, 0x74,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x69,
+	0x74, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x73, 0x69, 0x74, 0x65,
+	0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x49, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x49, 0x64, 0x22, 0x3a,
+	0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x6f, 0x77, 0x73, 0x41, 0x66,
+	0x66, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x72, 0x6f,
+	0x77, 0x73, 0x41, 0x66, 0x66, 0x65, 0x63, 0x74, 0x65, 0x64, 0x22, 0x5b, 0x0a, 0x11, 0x53, 0x65,
+	0x74, 0x50, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x69, 0x74, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x06, 0x73, 0x69, 0x74, 0x65, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x69, 0x64, 0x65, 0x6f,
+	0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x22, 0x38, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x50, 0x6c,
+	0x61, 0x6e, 0x6e, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a,
+	0x0c, 0x72, 0x6f, 0x77, 0x73, 0x41, 0x66, 0x66, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0c, 0x72, 0x6f, 0x77, 0x73, 0x41, 0x66, 0x66, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x22, 0x2a, 0x0a, 0x10, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x69, 0x74, 0x65, 0x49, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x73, 0x69, 0x74, 0x65, 0x49, 0x64, 0x22, 0x37, 0x0a,
+	0x11, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x6f, 0x77, 0x73, 0x41, 0x66, 0x66, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x72, 0x6f, 0x77, 0x73, 0x41, 0x66,
+	0x66, 0x65, 0x63, 0x74, 0x65, 0x64, 0x22, 0x43, 0x0a, 0x17, 0x41, 0x63, 0x6b, 0x6e, 0x6f, 0x77,
+	0x6c, 0x65, 0x64, 0x67, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x69, 0x74, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x06, 0x73, 0x69, 0x74, 0x65, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x69, 0x64, 0x73, 0x22, 0x4e, 0x0a, 0x18, 0x41,
+	0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x69, 0x74, 0x65, 0x49,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x73, 0x69, 0x74, 0x65, 0x49, 0x64, 0x12,
+	0x1a, 0x0a, 0x08, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x49, 0x64, 0x22, 0x49, 0x0a, 0x1b, 0x41,
+	0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x4f, 0x6c, 0x64, 0x65, 0x72, 0x54,
+	0x68, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x69,
+	0x74, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x73, 0x69, 0x74, 0x65,
+	0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x22, 0x5d, 0x0a, 0x13, 0x41, 0x63, 0x6b, 0x6e, 0x6f, 0x77,
+	0x6c, 0x65, 0x64, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a,
+	0x0c, 0x72, 0x6f, 0x77, 0x73, 0x41, 0x66, 0x66, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0c, 0x72, 0x6f, 0x77, 0x73, 0x41, 0x66, 0x66, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x4e, 0x65,
+	0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69,
+	0x6e, 0x67, 0x4e, 0x65, 0x77, 0x22, 0x83, 0x01, 0x0a, 0x15, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f,
+	0x61, 0x64, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x69, 0x74, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x06, 0x73, 0x69, 0x74, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x6c, 0x62, 0x75, 0x6d,
+	0x49, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x61, 0x6c, 0x62, 0x75, 0x6d,
+	0x49, 0x64, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x51, 0x75, 0x61, 0x6c,
+	0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x63, 0x6b,
+	0x51, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x73, 0x50, 0x6c, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x69, 0x73, 0x50, 0x6c, 0x22, 0x6f, 0x0a, 0x15, 0x44,
+	0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x69, 0x74, 0x65, 0x49, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x73, 0x69, 0x74, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08,
+	0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x63,
+	0x6b, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x74, 0x72, 0x61, 0x63, 0x6b, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x22, 0xa7, 0x01, 0x0a,
+	0x16, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x0a, 0x44, 0x6f, 0x77, 0x6e, 0x6c,
+	0x6f, 0x61, 0x64, 0x65, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x61, 0x72,
+	0x74, 0x69, 0x73, 0x74, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x41, 0x6c, 0x62,
+	0x75, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x6f, 0x77, 0x6e,
+	0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x44, 0x6f, 0x77,
+	0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x1a, 0x3d, 0x0a, 0x0f, 0x44, 0x6f, 0x77, 0x6e, 0x6c,
+	0x6f, 0x61, 0x64, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xa7, 0x01, 0x0a, 0x16, 0x44, 0x6f, 0x77, 0x6e, 0x6c,
+	0x6f, 0x61, 0x64, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x4e, 0x0a, 0x0a, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x2e, 0x44,
+	0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x65,
+	0x64, 0x1a, 0x3d, 0x0a, 0x0f, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x22, 0xc7, 0x02, 0x0a, 0x0d, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x69, 0x74, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x06, 0x73, 0x69, 0x74, 0x65, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x6c, 0x62, 0x75,
+	0x6d, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x6c, 0x62, 0x75, 0x6d,
+	0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x49, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74,
+	0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x12, 0x1e,
+	0x0a, 0x0a, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0a, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x14,
+	0x0a, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x62,
+	0x79, 0x74, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x79, 0x74,
+	0x65, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x42,
+	0x79, 0x74, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x70, 0x65, 0x65, 0x64, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x70, 0x65, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x71, 0x75,
+	0x61, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x71, 0x75, 0x61,
+	0x6c, 0x69, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x2b, 0x0a, 0x11, 0x4c, 0x69,
+	0x73, 0x74, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x69, 0x74, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x06, 0x73, 0x69, 0x74, 0x65, 0x49, 0x64, 0x22, 0x3e, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x41,
+	0x72, 0x74, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a,
+	0x07, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e,
+	0x2e, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x52, 0x07,
+	0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x73, 0x22, 0xd3, 0x03, 0x0a, 0x03, 0x4a, 0x6f, 0x62, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x69, 0x74, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x06, 0x73, 0x69, 0x74, 0x65, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6a, 0x6f, 0x62, 0x54, 0x79,
+	0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6a, 0x6f, 0x62, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x72, 0x74, 0x69, 0x73,
+	0x74, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x72, 0x74, 0x69, 0x73,
+	0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x49, 0x64, 0x73, 0x18,
+	0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x49, 0x64, 0x73, 0x12,
+	0x22, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x51, 0x75, 0x61, 0x6c,
+	0x69, 0x74, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x73, 0x50, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x04, 0x69, 0x73, 0x50, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x2f, 0x0a, 0x06, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x72, 0x74, 0x69,
+	0x73, 0x74, 0x2e, 0x4a, 0x6f, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x49, 0x64,
+	0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x49,
+	0x64, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xe8, 0x01,
+	0x0a, 0x10, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x69, 0x74, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x06, 0x73, 0x69, 0x74, 0x65, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6a, 0x6f,
+	0x62, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6a, 0x6f, 0x62,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x49, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x49, 0x64,
+	0x12, 0x1a, 0x0a, 0x08, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x49, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x08, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x49, 0x64, 0x73, 0x12, 0x22, 0x0a, 0x0c,
+	0x74, 0x72, 0x61, 0x63, 0x6b, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79,
+	0x12, 0x12, 0x0a, 0x04, 0x69, 0x73, 0x50, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04,
+	0x69, 0x73, 0x50, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x73, 0x41, 0x64, 0x64, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x05, 0x69, 0x73, 0x41, 0x64, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x72,
+	0x74, 0x69, 0x73, 0x74, 0x49, 0x64, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x61,
+	0x72, 0x74, 0x69, 0x73, 0x74, 0x49, 0x64, 0x73, 0x22, 0x22, 0x0a, 0x0a, 0x4a, 0x6f, 0x62, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x22, 0x41, 0x0a, 0x15,
+	0x42, 0x75, 0x6c, 0x6b, 0x41, 0x64, 0x64, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x69, 0x74, 0x65, 0x49, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x73, 0x69, 0x74, 0x65, 0x49, 0x64, 0x12, 0x10, 0x0a,
+	0x03, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x69, 0x64, 0x73, 0x22,
+	0x57, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x69, 0x74, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x06, 0x73, 0x69, 0x74, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x33, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74,
+	0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x04,
+	0x6a, 0x6f, 0x62, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x61, 0x72, 0x74,
+	0x69, 0x73, 0x74, 0x2e, 0x4a, 0x6f, 0x62, 0x52, 0x04, 0x6a, 0x6f, 0x62, 0x73, 0x22, 0x8b, 0x01,
+	0x0a, 0x0d, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x69, 0x74, 0x65, 0x49, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x73, 0x69, 0x74, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x61, 0x72, 0x6b, 0x53, 0x74, 0x61, 0x72, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x61, 0x72, 0x6b, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x61, 0x72, 0x6b, 0x45, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6d, 0x61, 0x72, 0x6b, 0x45, 0x6e, 0x64, 0x22, 0xf0, 0x01, 0x0a, 0x0c,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x69, 0x74, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x06, 0x73, 0x69, 0x74, 0x65, 0x49, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x73, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x73, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x72, 0x65,
+	0x6e, 0x74, 0x49, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x72, 0x65,
+	0x6e, 0x74, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x54, 0x69,
+	0x74, 0x6c, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x61, 0x72, 0x65, 0x6e,
+	0x74, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73,
+	0x65, 0x44, 0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x6c,
+	0x65, 0x61, 0x73, 0x65, 0x44, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72,
+	0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x22, 0x40,
+	0x0a, 0x0e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x2e, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73,
+	0x22, 0x45, 0x0a, 0x15, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x41, 0x72, 0x74, 0x69, 0x73,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x69, 0x74,
+	0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x73, 0x69, 0x74, 0x65, 0x49,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x7a, 0x0a, 0x0f, 0x53, 0x75, 0x67, 0x67, 0x65,
+	0x73, 0x74, 0x65, 0x64, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x06, 0x61, 0x72,
+	0x74, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x72, 0x74,
+	0x69, 0x73, 0x74, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x52, 0x06, 0x61, 0x72, 0x74, 0x69,
+	0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x29, 0x0a, 0x08, 0x72, 0x65, 0x6c, 0x65,
+	0x61, 0x73, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x61, 0x72, 0x74,
+	0x69, 0x73, 0x74, 0x2e, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x52, 0x08, 0x72, 0x65, 0x6c, 0x65, 0x61,
+	0x73, 0x65, 0x73, 0x22, 0x4b, 0x0a, 0x16, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x41, 0x72,
+	0x74, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a,
+	0x07, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x2e, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x65,
+	0x64, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x52, 0x07, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x73,
+	0x22, 0x64, 0x0a, 0x18, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x56, 0x69, 0x64, 0x65, 0x6f,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x69, 0x74, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x73, 0x69,
+	0x74, 0x65, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x79, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x04, 0x64, 0x61, 0x79, 0x73, 0x22, 0x3b, 0x0a, 0x19, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73,
+	0x68, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x22, 0x77, 0x0a, 0x11, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x69, 0x74, 0x65,
+	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x73, 0x69, 0x74, 0x65, 0x49, 0x64,
+	0x12, 0x18, 0x0a, 0x07, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x79, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x64, 0x61, 0x79, 0x73, 0x22, 0xa7, 0x01, 0x0a,
+	0x0f, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x73, 0x50, 0x6f, 0x69, 0x6e, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x61, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x74, 0x61, 0x6b, 0x65, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x76, 0x69, 0x65, 0x77, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x76, 0x69, 0x65, 0x77, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x76, 0x69, 0x64, 0x65,
+	0x6f, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x45, 0x0a, 0x12, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x06,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61,
+	0x72, 0x74, 0x69, 0x73, 0x74, 0x2e, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x22, 0xf2, 0x03,
+	0x0a, 0x07, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x75, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x69, 0x74,
+	0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x73, 0x69, 0x74, 0x65, 0x49,
+	0x64, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x49, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x49, 0x64, 0x12, 0x18, 0x0a,
+	0x07, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07,
+	0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x69, 0x73, 0x41, 0x64, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x69, 0x73,
+	0x41, 0x64, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x41, 0x74, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d,
+	0x73, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x6e, 0x65, 0x77, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x6e, 0x65, 0x77, 0x41,
+	0x6c, 0x62, 0x75, 0x6d, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2c, 0x0a, 0x11, 0x72, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x64, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x41, 0x6c, 0x62,
+	0x75, 0x6d, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x6e, 0x65, 0x77, 0x41, 0x72,
+	0x74, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0e, 0x6e, 0x65, 0x77, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x20, 0x0a, 0x0b, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0e,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x29, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73,
+	0x18, 0x10, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x2e,
+	0x53, 0x79, 0x6e, 0x63, 0x52, 0x75, 0x6e, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65,
+	0x6d, 0x73, 0x22, 0xc7, 0x02, 0x0a, 0x0b, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x75, 0x6e, 0x49, 0x74,
+	0x65, 0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x49, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x49, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74,
+	0x69, 0x74, 0x6c, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x6e, 0x65, 0x77, 0x41, 0x6c, 0x62, 0x75, 0x6d,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x6e, 0x65, 0x77,
+	0x41, 0x6c, 0x62, 0x75, 0x6d, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2c, 0x0a, 0x11, 0x72, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x64, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x41, 0x6c,
+	0x62, 0x75, 0x6d, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x6e, 0x65, 0x77, 0x41,
+	0x72, 0x74, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0e, 0x6e, 0x65, 0x77, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1e,
+	0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1e, 0x0a, 0x0a,
+	0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0a, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x22, 0x7f, 0x0a, 0x13,
+	0x4c, 0x69, 0x73, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x75, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x69, 0x74, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x06, 0x73, 0x69, 0x74, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x61,
+	0x72, 0x74, 0x69, 0x73, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61,
+	0x72, 0x74, 0x69, 0x73, 0x74, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x66, 0x61, 0x69, 0x6c, 0x65,
+	0x64, 0x4f, 0x6e, 0x6c, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x66, 0x61, 0x69,
+	0x6c, 0x65, 0x64, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x3b, 0x0a,
+	0x14, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x75, 0x6e, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x04, 0x72, 0x75, 0x6e, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x2e, 0x53, 0x79, 0x6e,
+	0x63, 0x52, 0x75, 0x6e, 0x52, 0x04, 0x72, 0x75, 0x6e, 0x73, 0x22, 0x46, 0x0a, 0x0e, 0x53, 0x79,
+	0x6e, 0x63, 0x52, 0x75, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x72, 0x75, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x72, 0x75, 0x6e,
+	0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x4f, 0x6e, 0x6c, 0x79,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x4f, 0x6e,
+	0x6c, 0x79, 0x22, 0xa6, 0x02, 0x0a, 0x0c, 0x53, 0x79, 0x6e, 0x63, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x69, 0x74, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x06, 0x73, 0x69, 0x74, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73,
+	0x70, 0x65, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x70, 0x65, 0x63, 0x12,
+	0x1c, 0x0a, 0x09, 0x6a, 0x69, 0x74, 0x74, 0x65, 0x72, 0x53, 0x65, 0x63, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x09, 0x6a, 0x69, 0x74, 0x74, 0x65, 0x72, 0x53, 0x65, 0x63, 0x12, 0x1e, 0x0a,
+	0x0a, 0x71, 0x75, 0x69, 0x65, 0x74, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x71, 0x75, 0x69, 0x65, 0x74, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x12, 0x18, 0x0a,
+	0x07, 0x6e, 0x65, 0x78, 0x74, 0x52, 0x75, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6e, 0x65, 0x78, 0x74, 0x52, 0x75, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x65, 0x78, 0x74, 0x52,
+	0x75, 0x6e, 0x49, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x65, 0x78, 0x74,
+	0x52, 0x75, 0x6e, 0x49, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x12,
+	0x18, 0x0a, 0x07, 0x6c, 0x61, 0x73, 0x74, 0x52, 0x75, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6c, 0x61, 0x73, 0x74, 0x52, 0x75, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x61, 0x73,
+	0x74, 0x52, 0x75, 0x6e, 0x49, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6c, 0x61,
+	0x73, 0x74, 0x52, 0x75, 0x6e, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x6b, 0x69, 0x70, 0x70,
+	0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x73,
+	0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x2d, 0x0a, 0x13, 0x53,
+	0x79, 0x6e, 0x63, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x69, 0x74, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x06, 0x73, 0x69, 0x74, 0x65, 0x49, 0x64, 0x22, 0x4a, 0x0a, 0x14, 0x53, 0x79,
+	0x6e, 0x63, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x32, 0x0a, 0x09, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x2e, 0x53,
+	0x79, 0x6e, 0x63, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x09, 0x73, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x22, 0xf2, 0x01, 0x0a, 0x0c, 0x44, 0x6f, 0x77, 0x6e, 0x6c,
+	0x6f, 0x61, 0x64, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x69, 0x74, 0x65, 0x49,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x73, 0x69, 0x74, 0x65, 0x49, 0x64, 0x12,
+	0x1a, 0x0a, 0x08, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x72,
+	0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x54, 0x79, 0x70, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x05, 0x52, 0x0c, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12,
+	0x14, 0x0a, 0x05, 0x6b, 0x69, 0x6e, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x05, 0x52, 0x05,
+	0x6b, 0x69, 0x6e, 0x64, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a,
+	0x07, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x71, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65,
+	0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x22, 0x2d, 0x0a, 0x13, 0x44,
+	0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x72, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x22, 0x4e, 0x0a, 0x18, 0x4c, 0x69,
+	0x73, 0x74, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x69, 0x74, 0x65, 0x49, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x73, 0x69, 0x74, 0x65, 0x49, 0x64, 0x12, 0x1a,
+	0x0a, 0x08, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x49, 0x64, 0x22, 0x47, 0x0a, 0x19, 0x4c, 0x69,
+	0x73, 0x74, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x2e,
+	0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x05, 0x72, 0x75,
+	0x6c, 0x65, 0x73, 0x22, 0x40, 0x0a, 0x1a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x6f, 0x77,
+	0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x6f, 0x77, 0x73, 0x41, 0x66, 0x66, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x72, 0x6f, 0x77, 0x73, 0x41, 0x66, 0x66,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x22, 0x7d, 0x0a, 0x1b, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x69, 0x74, 0x65, 0x49, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x73, 0x69, 0x74, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08,
+	0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74,
+	0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x05, 0x72,
+	0x75, 0x6c, 0x65, 0x73, 0x22, 0x9d, 0x01, 0x0a, 0x10, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61,
+	0x64, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x72, 0x74,
+	0x69, 0x73, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x72, 0x74,
+	0x69, 0x73, 0x74, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x05, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x2e, 0x41, 0x6c,
+	0x62, 0x75, 0x6d, 0x52, 0x05, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x75,
+	0x6c, 0x65, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x72, 0x75, 0x6c, 0x65,
+	0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x71, 0x75,
+	0x61, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x71, 0x75, 0x61,
+	0x6c, 0x69, 0x74, 0x79, 0x22, 0x56, 0x0a, 0x1c, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x44,
+	0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x09, 0x64, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74,
+	0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x09, 0x64, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x7e, 0x0a, 0x16,
+	0x53, 0x65, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x69, 0x74, 0x65, 0x49, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x73, 0x69, 0x74, 0x65, 0x49, 0x64, 0x12, 0x1a,
+	0x0a, 0x08, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x08, 0x73, 0x65,
+	0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61,
+	0x72, 0x74, 0x69, 0x73, 0x74, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e,
+	0x67, 0x73, 0x52, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x7a, 0x0a, 0x04,
+	0x53, 0x69, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x69, 0x74, 0x65, 0x49, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x73, 0x69, 0x74, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74,
+	0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x61, 0x73, 0x50,
+	0x61, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x68, 0x61, 0x73, 0x50, 0x61,
+	0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x12, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74,
+	0x53, 0x69, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x37, 0x0a, 0x11,
+	0x4c, 0x69, 0x73, 0x74, 0x53, 0x69, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x22, 0x0a, 0x05, 0x73, 0x69, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0c, 0x2e, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x52, 0x05,
+	0x73, 0x69, 0x74, 0x65, 0x73, 0x22, 0xa2, 0x01, 0x0a, 0x1c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x53, 0x69, 0x74, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x69, 0x74, 0x65, 0x49, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x73, 0x69, 0x74, 0x65, 0x49, 0x64, 0x12, 0x19,
+	0x0a, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
+	0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x70, 0x61, 0x73,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x04, 0x70, 0x61, 0x73, 0x73, 0x88,
+	0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x02, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a,
+	0x06, 0x5f, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x70, 0x61, 0x73, 0x73,
+	0x42, 0x08, 0x0a, 0x06, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x5d, 0x0a, 0x1e, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x53, 0x69, 0x74, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x69, 0x74, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x73, 0x69,
+	0x74, 0x65, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x88, 0x01, 0x01, 0x42,
+	0x08, 0x0a, 0x06, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x69, 0x0a, 0x1f, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x65, 0x53, 0x69, 0x74, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x22, 0x3a, 0x0a, 0x0c, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x69, 0x74, 0x65, 0x49, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x73, 0x69, 0x74, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x64, 0x61, 0x79, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x64, 0x61, 0x79, 0x73,
+	0x22, 0x62, 0x0a, 0x0a, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x10,
+	0x0a, 0x03, 0x64, 0x61, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x64, 0x61, 0x79,
+	0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x6e, 0x69, 0x74,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x12, 0x14,
+	0x0a, 0x05, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63,
+	0x61, 0x6c, 0x6c, 0x73, 0x22, 0x91, 0x01, 0x0a, 0x0d, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x61, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x64, 0x61, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x75, 0x73, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x62, 0x75,
+	0x64, 0x67, 0x65, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x65, 0x74, 0x41, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x73, 0x65, 0x74, 0x41, 0x74, 0x12, 0x28,
+	0x0a, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x55, 0x73, 0x61, 0x67,
+	0x65, 0x52, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x32, 0xe4, 0x12, 0x0a, 0x0d, 0x41, 0x72, 0x74,
+	0x69, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x43, 0x0a, 0x0a, 0x53, 0x79,
+	0x6e, 0x63, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x12, 0x19, 0x2e, 0x61, 0x72, 0x74, 0x69, 0x73,
+	0x74, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x2e, 0x53, 0x79, 0x6e,
+	0x63, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x48, 0x0a, 0x10, 0x53, 0x79, 0x6e, 0x63, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x12, 0x19, 0x2e, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x2e, 0x53, 0x79, 0x6e,
+	0x63, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17,
+	0x2e, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x41, 0x72, 0x74, 0x69,
+	0x73, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x30, 0x01, 0x12, 0x53, 0x0a, 0x10, 0x52, 0x65, 0x61,
+	0x64, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x73, 0x12, 0x1e, 0x2e,
+	0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x41, 0x72, 0x74, 0x69, 0x73,
+	0x74, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e,
+	0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x41, 0x72, 0x74, 0x69, 0x73,
+	0x74, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52,
+	0x0a, 0x0f, 0x52, 0x65, 0x61, 0x64, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x54, 0x72, 0x61, 0x63, 0x6b,
+	0x73, 0x12, 0x1e, 0x2e, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x41,
+	0x6c, 0x62, 0x75, 0x6d, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1f, 0x2e, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x41,
+	0x6c, 0x62, 0x75, 0x6d, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x49, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x72, 0x74, 0x69,
+	0x73, 0x74, 0x12, 0x1b, 0x2e, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1c, 0x2e, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41,
+	0x72, 0x74, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a,
+	0x0a, 0x53, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x12, 0x19, 0x2e, 0x61, 0x72,
+	0x74, 0x69, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x2e,
+	0x53, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x40, 0x0a, 0x09, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x53, 0x79, 0x6e, 0x63, 0x12,
+	0x18, 0x2e, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x2e, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x53, 0x79,
+	0x6e, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x61, 0x72, 0x74, 0x69,
+	0x73, 0x74, 0x2e, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x10, 0x41, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65,
+	0x64, 0x67, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x1f, 0x2e, 0x61, 0x72, 0x74, 0x69, 0x73,
+	0x74, 0x2e, 0x41, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x49, 0x74, 0x65,
+	0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x61, 0x72, 0x74, 0x69,
+	0x73, 0x74, 0x2e, 0x41, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x11, 0x41, 0x63, 0x6b, 0x6e, 0x6f, 0x77,
+	0x6c, 0x65, 0x64, 0x67, 0x65, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x12, 0x20, 0x2e, 0x61, 0x72,
+	0x74, 0x69, 0x73, 0x74, 0x2e, 0x41, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65,
+	0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e,
+	0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x2e, 0x41, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64,
+	0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a, 0x14, 0x41, 0x63,
+	0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x4f, 0x6c, 0x64, 0x65, 0x72, 0x54, 0x68,
+	0x61, 0x6e, 0x12, 0x23, 0x2e, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x2e, 0x41, 0x63, 0x6b, 0x6e,
+	0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x4f, 0x6c, 0x64, 0x65, 0x72, 0x54, 0x68, 0x61, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74,
+	0x2e, 0x41, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x0e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64,
+	0x41, 0x6c, 0x62, 0x75, 0x6d, 0x73, 0x12, 0x1d, 0x2e, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x2e,
+	0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x2e, 0x44,
+	0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x0e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61,
+	0x64, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x12, 0x1d, 0x2e, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74,
+	0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x2e,
+	0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x14, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f,
+	0x61, 0x64, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x73, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x1d,
+	0x2e, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64,
+	0x41, 0x6c, 0x62, 0x75, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e,
+	0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x30, 0x01, 0x12, 0x43, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x72,
+	0x74, 0x69, 0x73, 0x74, 0x12, 0x19, 0x2e, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1a, 0x2e, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x72, 0x74,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x09, 0x53,
+	0x75, 0x62, 0x6d, 0x69, 0x74, 0x4a, 0x6f, 0x62, 0x12, 0x18, 0x2e, 0x61, 0x72, 0x74, 0x69, 0x73,
+	0x74, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x0b, 0x2e, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x2e, 0x4a, 0x6f, 0x62, 0x12,
+	0x29, 0x0a, 0x06, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x12, 0x12, 0x2e, 0x61, 0x72, 0x74, 0x69,
+	0x73, 0x74, 0x2e, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0b, 0x2e,
+	0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x2e, 0x4a, 0x6f, 0x62, 0x12, 0x3d, 0x0a, 0x08, 0x4c, 0x69,
+	0x73, 0x74, 0x4a, 0x6f, 0x62, 0x73, 0x12, 0x17, 0x2e, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x18, 0x2e, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x09, 0x43, 0x61, 0x6e,
+	0x63, 0x65, 0x6c, 0x4a, 0x6f, 0x62, 0x12, 0x12, 0x2e, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x2e,
+	0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0b, 0x2e, 0x61, 0x72, 0x74,
+	0x69, 0x73, 0x74, 0x2e, 0x4a, 0x6f, 0x62, 0x12, 0x2b, 0x0a, 0x08, 0x52, 0x65, 0x74, 0x72, 0x79,
+	0x4a, 0x6f, 0x62, 0x12, 0x12, 0x2e, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x2e, 0x4a, 0x6f, 0x62,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0b, 0x2e, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74,
+	0x2e, 0x4a, 0x6f, 0x62, 0x12, 0x3c, 0x0a, 0x0e, 0x42, 0x75, 0x6c, 0x6b, 0x41, 0x64, 0x64, 0x41,
+	0x72, 0x74, 0x69, 0x73, 0x74, 0x73, 0x12, 0x1d, 0x2e, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x2e,
+	0x42, 0x75, 0x6c, 0x6b, 0x41, 0x64, 0x64, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0b, 0x2e, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x2e, 0x4a,
+	0x6f, 0x62, 0x12, 0x37, 0x0a, 0x06, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x15, 0x2e, 0x61,
+	0x72, 0x74, 0x69, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x0e, 0x53,
+	0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x73, 0x12, 0x1d, 0x2e,
+	0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x2e, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x41, 0x72,
+	0x74, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x61,
+	0x72, 0x74, 0x69, 0x73, 0x74, 0x2e, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x41, 0x72, 0x74,
+	0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a, 0x11,
+	0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x12, 0x20, 0x2e, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x66, 0x72, 0x65,
+	0x73, 0x68, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x66,
+	0x72, 0x65, 0x73, 0x68, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x56, 0x69, 0x64,
+	0x65, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x19, 0x2e, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74,
+	0x2e, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x2e, 0x56, 0x69, 0x64, 0x65,
+	0x6f, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47,
+	0x0a, 0x0f, 0x53, 0x65, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
+	0x73, 0x12, 0x1e, 0x2e, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x74, 0x53, 0x79,
+	0x6e, 0x63, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x14, 0x2e, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x53,
+	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x49, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x53,
+	0x79, 0x6e, 0x63, 0x52, 0x75, 0x6e, 0x73, 0x12, 0x1b, 0x2e, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x75, 0x6e, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x75, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x35, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x75, 0x6e,
+	0x12, 0x16, 0x2e, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x75,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x61, 0x72, 0x74, 0x69, 0x73,
+	0x74, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x75, 0x6e, 0x12, 0x4c, 0x0a, 0x0f, 0x47, 0x65, 0x74,
+	0x53, 0x79, 0x6e, 0x63, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x1b, 0x2e, 0x61,
+	0x72, 0x74, 0x69, 0x73, 0x74, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x61, 0x72, 0x74, 0x69,
+	0x73, 0x74, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x14, 0x2e,
+	0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x52,
+	0x75, 0x6c, 0x65, 0x1a, 0x14, 0x2e, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x2e, 0x44, 0x6f, 0x77,
+	0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x40, 0x0a, 0x12, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x75, 0x6c, 0x65, 0x12,
+	0x14, 0x2e, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61,
+	0x64, 0x52, 0x75, 0x6c, 0x65, 0x1a, 0x14, 0x2e, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x2e, 0x44,
+	0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x55, 0x0a, 0x12, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x75, 0x6c,
+	0x65, 0x12, 0x1b, 0x2e, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c,
+	0x6f, 0x61, 0x64, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22,
+	0x2e, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x6f,
+	0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x58, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f,
+	0x61, 0x64, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x20, 0x2e, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x75, 0x6c,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x61, 0x72, 0x74, 0x69,
+	0x73, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x52,
+	0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x61, 0x0a, 0x14,
+	0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x52,
+	0x75, 0x6c, 0x65, 0x73, 0x12, 0x23, 0x2e, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x2e, 0x50, 0x72,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x75, 0x6c,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x61, 0x72, 0x74, 0x69,
+	0x73, 0x74, 0x2e, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f,
+	0x61, 0x64, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32,
+	0xc2, 0x02, 0x0a, 0x0c, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x12, 0x40, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x69, 0x74, 0x65, 0x73, 0x12, 0x18, 0x2e,
+	0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x69, 0x74, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x69, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x4b, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x69, 0x74, 0x65,
+	0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x24, 0x2e, 0x61, 0x72,
+	0x74, 0x69, 0x73, 0x74, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x69, 0x74, 0x65, 0x43,
+	0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x0c, 0x2e, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x12,
+	0x6a, 0x0a, 0x17, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x53, 0x69, 0x74, 0x65, 0x43,
+	0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x26, 0x2e, 0x61, 0x72, 0x74,
+	0x69, 0x73, 0x74, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x53, 0x69, 0x74, 0x65,
+	0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x27, 0x2e, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x2e, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x65, 0x53, 0x69, 0x74, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x61, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x08, 0x47,
+	0x65, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x12, 0x14, 0x2e, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74,
+	0x2e, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e,
+	0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x42, 0x26, 0x5a, 0x24, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x76, 0x30, 0x76, 0x63, 0x2f, 0x67, 0x6f, 0x2d, 0x6d, 0x75, 0x73, 0x69, 0x63,
+	0x2d, 0x67, 0x72, 0x70, 0x63, 0x3b, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_artist_proto_rawDescOnce sync.Once
+	file_artist_proto_rawDescData = file_artist_proto_rawDesc
+)
+
+func file_artist_proto_rawDescGZIP() []byte {
+	file_artist_proto_rawDescOnce.Do(func() {
+		file_artist_proto_rawDescData = protoimpl.X.CompressGZIP(file_artist_proto_rawDescData)
+	})
+	return file_artist_proto_rawDescData
+}
+
+var file_artist_proto_msgTypes = make([]protoimpl.MessageInfo, 76)
+var file_artist_proto_goTypes = []interface{}{
+	(*Artist)(nil),                          // 0: artist.Artist
+	(*SyncSettings)(nil),                    // 1: artist.SyncSettings
+	(*Album)(nil),                           // 2: artist.Album
+	(*Track)(nil),                           // 3: artist.Track
+	(*Playlist)(nil),                        // 4: artist.Playlist
+	(*SyncArtistRequest)(nil),               // 5: artist.SyncArtistRequest
+	(*SyncArtistResponse)(nil),              // 6: artist.SyncArtistResponse
+	(*SyncArtistEvent)(nil),                 // 7: artist.SyncArtistEvent
+	(*SyncArtistSummary)(nil),               // 8: artist.SyncArtistSummary
+	(*ReadArtistAlbumRequest)(nil),          // 9: artist.ReadArtistAlbumRequest
+	(*ReadArtistAlbumResponse)(nil),         // 10: artist.ReadArtistAlbumResponse
+	(*ReadAlbumTracksRequest)(nil),          // 11: artist.ReadAlbumTracksRequest
+	(*ReadAlbumTracksResponse)(nil),         // 12: artist.ReadAlbumTracksResponse
+	(*DeleteArtistRequest)(nil),             // 13: artist.DeleteArtistRequest
+	(*DeleteArtistResponse)(nil),            // 14: artist.DeleteArtistResponse
+	(*SetPlannedRequest)(nil),               // 15: artist.SetPlannedRequest
+	(*SetPlannedResponse)(nil),              // 16: artist.SetPlannedResponse
+	(*ClearSyncRequest)(nil),                // 17: artist.ClearSyncRequest
+	(*ClearSyncResponse)(nil),               // 18: artist.ClearSyncResponse
+	(*AcknowledgeItemsRequest)(nil),         // 19: artist.AcknowledgeItemsRequest
+	(*AcknowledgeArtistRequest)(nil),        // 20: artist.AcknowledgeArtistRequest
+	(*AcknowledgeOlderThanRequest)(nil),     // 21: artist.AcknowledgeOlderThanRequest
+	(*AcknowledgeResponse)(nil),             // 22: artist.AcknowledgeResponse
+	(*DownloadAlbumsRequest)(nil),           // 23: artist.DownloadAlbumsRequest
+	(*DownloadArtistRequest)(nil),           // 24: artist.DownloadArtistRequest
+	(*DownloadAlbumsResponse)(nil),          // 25: artist.DownloadAlbumsResponse
+	(*DownloadTracksResponse)(nil),          // 26: artist.DownloadTracksResponse
+	(*DownloadEvent)(nil),                   // 27: artist.DownloadEvent
+	(*ListArtistRequest)(nil),               // 28: artist.ListArtistRequest
+	(*ListArtistResponse)(nil),              // 29: artist.ListArtistResponse
+	(*Job)(nil),                             // 30: artist.Job
+	(*SubmitJobRequest)(nil),                // 31: artist.SubmitJobRequest
+	(*JobRequest)(nil),                      // 32: artist.JobRequest
+	(*BulkAddArtistsRequest)(nil),           // 33: artist.BulkAddArtistsRequest
+	(*ListJobsRequest)(nil),                 // 34: artist.ListJobsRequest
+	(*ListJobsResponse)(nil),                // 35: artist.ListJobsResponse
+	(*SearchRequest)(nil),                   // 36: artist.SearchRequest
+	(*SearchResult)(nil),                    // 37: artist.SearchResult
+	(*SearchResponse)(nil),                  // 38: artist.SearchResponse
+	(*SuggestArtistsRequest)(nil),           // 39: artist.SuggestArtistsRequest
+	(*SuggestedArtist)(nil),                 // 40: artist.SuggestedArtist
+	(*SuggestArtistsResponse)(nil),          // 41: artist.SuggestArtistsResponse
+	(*RefreshVideoStatsRequest)(nil),        // 42: artist.RefreshVideoStatsRequest
+	(*RefreshVideoStatsResponse)(nil),       // 43: artist.RefreshVideoStatsResponse
+	(*VideoStatsRequest)(nil),               // 44: artist.VideoStatsRequest
+	(*VideoStatsPoint)(nil),                 // 45: artist.VideoStatsPoint
+	(*VideoStatsResponse)(nil),              // 46: artist.VideoStatsResponse
+	(*SyncRun)(nil),                         // 47: artist.SyncRun
+	(*SyncRunItem)(nil),                     // 48: artist.SyncRunItem
+	(*ListSyncRunsRequest)(nil),             // 49: artist.ListSyncRunsRequest
+	(*ListSyncRunsResponse)(nil),            // 50: artist.ListSyncRunsResponse
+	(*SyncRunRequest)(nil),                  // 51: artist.SyncRunRequest
+	(*SyncSchedule)(nil),                    // 52: artist.SyncSchedule
+	(*SyncScheduleRequest)(nil),             // 53: artist.SyncScheduleRequest
+	(*SyncScheduleResponse)(nil),            // 54: artist.SyncScheduleResponse
+	(*DownloadRule)(nil),                    // 55: artist.DownloadRule
+	(*DownloadRuleRequest)(nil),             // 56: artist.DownloadRuleRequest
+	(*ListDownloadRulesRequest)(nil),        // 57: artist.ListDownloadRulesRequest
+	(*ListDownloadRulesResponse)(nil),       // 58: artist.ListDownloadRulesResponse
+	(*DeleteDownloadRuleResponse)(nil),      // 59: artist.DeleteDownloadRuleResponse
+	(*PreviewDownloadRulesRequest)(nil),     // 60: artist.PreviewDownloadRulesRequest
+	(*DownloadDecision)(nil),                // 61: artist.DownloadDecision
+	(*PreviewDownloadRulesResponse)(nil),    // 62: artist.PreviewDownloadRulesResponse
+	(*SetSyncSettingsRequest)(nil),          // 63: artist.SetSyncSettingsRequest
+	(*Site)(nil),                            // 64: artist.Site
+	(*ListSitesRequest)(nil),                // 65: artist.ListSitesRequest
+	(*ListSitesResponse)(nil),               // 66: artist.ListSitesResponse
+	(*UpdateSiteCredentialsRequest)(nil),    // 67: artist.UpdateSiteCredentialsRequest
+	(*ValidateSiteCredentialsRequest)(nil),  // 68: artist.ValidateSiteCredentialsRequest
+	(*ValidateSiteCredentialsResponse)(nil), // 69: artist.ValidateSiteCredentialsResponse
+	(*QuotaRequest)(nil),                    // 70: artist.QuotaRequest
+	(*QuotaUsage)(nil),                      // 71: artist.QuotaUsage
+	(*QuotaResponse)(nil),                   // 72: artist.QuotaResponse
+	nil,                                     // 73: artist.DownloadAlbumsResponse.DownloadedEntry
+	nil,                                     // 74: artist.DownloadTracksResponse.DownloadedEntry
+	nil,                                     // 75: artist.Job.ResultEntry
+}
+var file_artist_proto_depIdxs = []int32{
+	2,  // 0: artist.Artist.albums:type_name -> artist.Album
+	4,  // 1: artist.Artist.playlists:type_name -> artist.Playlist
+	1,  // 2: artist.Artist.sync:type_name -> artist.SyncSettings
+	0,  // 3: artist.SyncArtistResponse.artists:type_name -> artist.Artist
+	2,  // 4: artist.SyncArtistEvent.albums:type_name -> artist.Album
+	0,  // 5: artist.SyncArtistEvent.artists:type_name -> artist.Artist
+	8,  // 6: artist.SyncArtistEvent.summary:type_name -> artist.SyncArtistSummary
+	2,  // 7: artist.ReadArtistAlbumResponse.releases:type_name -> artist.Album
+	4,  // 8: artist.ReadArtistAlbumResponse.playlists:type_name -> artist.Playlist
+	3,  // 9: artist.ReadAlbumTracksResponse.tracks:type_name -> artist.Track
+	73, // 10: artist.DownloadAlbumsResponse.Downloaded:type_name -> artist.DownloadAlbumsResponse.DownloadedEntry
+	74, // 11: artist.DownloadTracksResponse.Downloaded:type_name -> artist.DownloadTracksResponse.DownloadedEntry
+	0,  // 12: artist.ListArtistResponse.artists:type_name -> artist.Artist
+	75, // 13: artist.Job.result:type_name -> artist.Job.ResultEntry
+	30, // 14: artist.ListJobsResponse.jobs:type_name -> artist.Job
+	37, // 15: artist.SearchResponse.results:type_name -> artist.SearchResult
+	0,  // 16: artist.SuggestedArtist.artist:type_name -> artist.Artist
+	2,  // 17: artist.SuggestedArtist.releases:type_name -> artist.Album
+	40, // 18: artist.SuggestArtistsResponse.artists:type_name -> artist.SuggestedArtist
+	45, // 19: artist.VideoStatsResponse.points:type_name -> artist.VideoStatsPoint
+	48, // 20: artist.SyncRun.items:type_name -> artist.SyncRunItem
+	47, // 21: artist.ListSyncRunsResponse.runs:type_name -> artist.SyncRun
+	52, // 22: artist.SyncScheduleResponse.schedules:type_name -> artist.SyncSchedule
+	55, // 23: artist.ListDownloadRulesResponse.rules:type_name -> artist.DownloadRule
+	55, // 24: artist.PreviewDownloadRulesRequest.rules:type_name -> artist.DownloadRule
+	2,  // 25: artist.DownloadDecision.album:type_name -> artist.Album
+	61, // 26: artist.PreviewDownloadRulesResponse.decisions:type_name -> artist.DownloadDecision
+	1,  // 27: artist.SetSyncSettingsRequest.settings:type_name -> artist.SyncSettings
+	64, // 28: artist.ListSitesResponse.sites:type_name -> artist.Site
+	71, // 29: artist.QuotaResponse.usage:type_name -> artist.QuotaUsage
+	5,  // 30: artist.ArtistService.SyncArtist:input_type -> artist.SyncArtistRequest
+	5,  // 31: artist.ArtistService.SyncArtistStream:input_type -> artist.SyncArtistRequest
+	9,  // 32: artist.ArtistService.ReadArtistAlbums:input_type -> artist.ReadArtistAlbumRequest
+	11, // 33: artist.ArtistService.ReadAlbumTracks:input_type -> artist.ReadAlbumTracksRequest
+	13, // 34: artist.ArtistService.DeleteArtist:input_type -> artist.DeleteArtistRequest
+	15, // 35: artist.ArtistService.SetPlanned:input_type -> artist.SetPlannedRequest
+	17, // 36: artist.ArtistService.ClearSync:input_type -> artist.ClearSyncRequest
+	19, // 37: artist.ArtistService.AcknowledgeItems:input_type -> artist.AcknowledgeItemsRequest
+	20, // 38: artist.ArtistService.AcknowledgeArtist:input_type -> artist.AcknowledgeArtistRequest
+	21, // 39: artist.ArtistService.AcknowledgeOlderThan:input_type -> artist.AcknowledgeOlderThanRequest
+	23, // 40: artist.ArtistService.DownloadAlbums:input_type -> artist.DownloadAlbumsRequest
+	24, // 41: artist.ArtistService.DownloadArtist:input_type -> artist.DownloadArtistRequest
+	23, // 42: artist.ArtistService.DownloadAlbumsStream:input_type -> artist.DownloadAlbumsRequest
+	28, // 43: artist.ArtistService.ListArtist:input_type -> artist.ListArtistRequest
+	31, // 44: artist.ArtistService.SubmitJob:input_type -> artist.SubmitJobRequest
+	32, // 45: artist.ArtistService.GetJob:input_type -> artist.JobRequest
+	34, // 46: artist.ArtistService.ListJobs:input_type -> artist.ListJobsRequest
+	32, // 47: artist.ArtistService.CancelJob:input_type -> artist.JobRequest
+	32, // 48: artist.ArtistService.RetryJob:input_type -> artist.JobRequest
+	33, // 49: artist.ArtistService.BulkAddArtists:input_type -> artist.BulkAddArtistsRequest
+	36, // 50: artist.ArtistService.Search:input_type -> artist.SearchRequest
+	39, // 51: artist.ArtistService.SuggestArtists:input_type -> artist.SuggestArtistsRequest
+	42, // 52: artist.ArtistService.RefreshVideoStats:input_type -> artist.RefreshVideoStatsRequest
+	44, // 53: artist.ArtistService.GetVideoStats:input_type -> artist.VideoStatsRequest
+	63, // 54: artist.ArtistService.SetSyncSettings:input_type -> artist.SetSyncSettingsRequest
+	49, // 55: artist.ArtistService.ListSyncRuns:input_type -> artist.ListSyncRunsRequest
+	51, // 56: artist.ArtistService.GetSyncRun:input_type -> artist.SyncRunRequest
+	53, // 57: artist.ArtistService.GetSyncSchedule:input_type -> artist.SyncScheduleRequest
+	55, // 58: artist.ArtistService.CreateDownloadRule:input_type -> artist.DownloadRule
+	55, // 59: artist.ArtistService.UpdateDownloadRule:input_type -> artist.DownloadRule
+	56, // 60: artist.ArtistService.DeleteDownloadRule:input_type -> artist.DownloadRuleRequest
+	57, // 61: artist.ArtistService.ListDownloadRules:input_type -> artist.ListDownloadRulesRequest
+	60, // 62: artist.ArtistService.PreviewDownloadRules:input_type -> artist.PreviewDownloadRulesRequest
+	65, // 63: artist.AdminService.ListSites:input_type -> artist.ListSitesRequest
+	67, // 64: artist.AdminService.UpdateSiteCredentials:input_type -> artist.UpdateSiteCredentialsRequest
+	68, // 65: artist.AdminService.ValidateSiteCredentials:input_type -> artist.ValidateSiteCredentialsRequest
+	70, // 66: artist.AdminService.GetQuota:input_type -> artist.QuotaRequest
+	6,  // 67: artist.ArtistService.SyncArtist:output_type -> artist.SyncArtistResponse
+	7,  // 68: artist.ArtistService.SyncArtistStream:output_type -> artist.SyncArtistEvent
+	10, // 69: artist.ArtistService.ReadArtistAlbums:output_type -> artist.ReadArtistAlbumResponse
+	12, // 70: artist.ArtistService.ReadAlbumTracks:output_type -> artist.ReadAlbumTracksResponse
+	14, // 71: artist.ArtistService.DeleteArtist:output_type -> artist.DeleteArtistResponse
+	16, // 72: artist.ArtistService.SetPlanned:output_type -> artist.SetPlannedResponse
+	18, // 73: artist.ArtistService.ClearSync:output_type -> artist.ClearSyncResponse
+	22, // 74: artist.ArtistService.AcknowledgeItems:output_type -> artist.AcknowledgeResponse
+	22, // 75: artist.ArtistService.AcknowledgeArtist:output_type -> artist.AcknowledgeResponse
+	22, // 76: artist.ArtistService.AcknowledgeOlderThan:output_type -> artist.AcknowledgeResponse
+	25, // 77: artist.ArtistService.DownloadAlbums:output_type -> artist.DownloadAlbumsResponse
+	25, // 78: artist.ArtistService.DownloadArtist:output_type -> artist.DownloadAlbumsResponse
+	27, // 79: artist.ArtistService.DownloadAlbumsStream:output_type -> artist.DownloadEvent
+	29, // 80: artist.ArtistService.ListArtist:output_type -> artist.ListArtistResponse
+	30, // 81: artist.ArtistService.SubmitJob:output_type -> artist.Job
+	30, // 82: artist.ArtistService.GetJob:output_type -> artist.Job
+	35, // 83: artist.ArtistService.ListJobs:output_type -> artist.ListJobsResponse
+	30, // 84: artist.ArtistService.CancelJob:output_type -> artist.Job
+	30, // 85: artist.ArtistService.RetryJob:output_type -> artist.Job
+	30, // 86: artist.ArtistService.BulkAddArtists:output_type -> artist.Job
+	38, // 87: artist.ArtistService.Search:output_type -> artist.SearchResponse
+	41, // 88: artist.ArtistService.SuggestArtists:output_type -> artist.SuggestArtistsResponse
+	43, // 89: artist.ArtistService.RefreshVideoStats:output_type -> artist.RefreshVideoStatsResponse
+	46, // 90: artist.ArtistService.GetVideoStats:output_type -> artist.VideoStatsResponse
+	1,  // 91: artist.ArtistService.SetSyncSettings:output_type -> artist.SyncSettings
+	50, // 92: artist.ArtistService.ListSyncRuns:output_type -> artist.ListSyncRunsResponse
+	47, // 93: artist.ArtistService.GetSyncRun:output_type -> artist.SyncRun
+	54, // 94: artist.ArtistService.GetSyncSchedule:output_type -> artist.SyncScheduleResponse
+	55, // 95: artist.ArtistService.CreateDownloadRule:output_type -> artist.DownloadRule
+	55, // 96: artist.ArtistService.UpdateDownloadRule:output_type -> artist.DownloadRule
+	59, // 97: artist.ArtistService.DeleteDownloadRule:output_type -> artist.DeleteDownloadRuleResponse
+	58, // 98: artist.ArtistService.ListDownloadRules:output_type -> artist.ListDownloadRulesResponse
+	62, // 99: artist.ArtistService.PreviewDownloadRules:output_type -> artist.PreviewDownloadRulesResponse
+	66, // 100: artist.AdminService.ListSites:output_type -> artist.ListSitesResponse
+	64, // 101: artist.AdminService.UpdateSiteCredentials:output_type -> artist.Site
+	69, // 102: artist.AdminService.ValidateSiteCredentials:output_type -> artist.ValidateSiteCredentialsResponse
+	72, // 103: artist.AdminService.GetQuota:output_type -> artist.QuotaResponse
+	67, // [67:104] is the sub-list for method output_type
+	30, // [30:67] is the sub-list for method input_type
+	30, // [30:30] is the sub-list for extension type_name
+	30, // [30:30] is the sub-list for extension extendee
+	0,  // [0:30] is the sub-list for field type_name
+}
+
+func init() { file_artist_proto_init() }
+func file_artist_proto_init() {
+	if File_artist_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_artist_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Artist); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_artist_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SyncSettings); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_artist_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Album); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_artist_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Track); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_artist_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Playlist); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_artist_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SyncArtistRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_artist_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SyncArtistResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_artist_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SyncArtistEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_artist_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SyncArtistSummary); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_artist_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadArtistAlbumRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_artist_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadArtistAlbumResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_artist_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadAlbumTracksRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_artist_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadAlbumTracksResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_artist_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteArtistRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_artist_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteArtistResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_artist_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetPlannedRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_artist_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetPlannedResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_artist_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClearSyncRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_artist_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClearSyncResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_artist_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcknowledgeItemsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_artist_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcknowledgeArtistRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_artist_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcknowledgeOlderThanRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_artist_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcknowledgeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_artist_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DownloadAlbumsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_artist_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DownloadArtistRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_artist_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DownloadAlbumsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_artist_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DownloadTracksResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_artist_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DownloadEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_artist_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListArtistRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_artist_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListArtistResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_artist_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Job); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_artist_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmitJobRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_artist_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JobRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_artist_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BulkAddArtistsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_artist_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListJobsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_artist_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListJobsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_artist_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_artist_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_artist_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_artist_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SuggestArtistsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_artist_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SuggestedArtist); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_artist_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SuggestArtistsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_artist_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RefreshVideoStatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_artist_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RefreshVideoStatsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_artist_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VideoStatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_artist_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VideoStatsPoint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_artist_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VideoStatsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_artist_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SyncRun); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_artist_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SyncRunItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_artist_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSyncRunsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_artist_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSyncRunsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ListArtistRequest.ProtoReflect.Descriptor instead.
-func (*ListArtistRequest) Descriptor() ([]byte, []int) {
-	return file_artist_proto_rawDescGZIP(), []int{17}
-}
-
-func (x *ListArtistRequest) GetSiteId() uint32 {
-	if x != nil {
-		return x.SiteId
-	}
-	return 0
-}
-
-type ListArtistResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Artists []*Artist `protobuf:"bytes,1,rep,name=artists,proto3" json:"artists,omitempty"`
-}
-
-func (x *ListArtistResponse) Reset() {
-	*x = ListArtistResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_artist_proto_msgTypes[18]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *ListArtistResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*ListArtistResponse) ProtoMessage() {}
-
-func (x *ListArtistResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_artist_proto_msgTypes[18]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
+		file_artist_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SyncRunRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ListArtistResponse.ProtoReflect.Descriptor instead.
-func (*ListArtistResponse) Descriptor() ([]byte, []int) {
-	return file_artist_proto_rawDescGZIP(), []int{18}
-}
-
-func (x *ListArtistResponse) GetArtists() []*Artist {
-	if x != nil {
-		return x.Artists
-	}
-	return nil
-}
-
-var File_artist_proto protoreflect.FileDescriptor
-
-var file_artist_proto_rawDesc = []byte{
-	0x0a, 0x0c, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06,
-	0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x22, 0xaf, 0x02, 0x0a, 0x06, 0x41, 0x72, 0x74, 0x69, 0x73,
-	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69,
-	0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x69, 0x74, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x06, 0x73, 0x69, 0x74, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x72, 0x74,
-	0x69, 0x73, 0x74, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x72, 0x74,
-	0x69, 0x73, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74,
-	0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09,
-	0x74, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x73, 0x65,
-	0x72, 0x41, 0x64, 0x64, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x75, 0x73,
-	0x65, 0x72, 0x41, 0x64, 0x64, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x77, 0x41, 0x6c,
-	0x62, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6e, 0x65, 0x77, 0x41, 0x6c, 0x62,
-	0x73, 0x12, 0x25, 0x0a, 0x06, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x0d, 0x2e, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x2e, 0x41, 0x6c, 0x62, 0x75, 0x6d,
-	0x52, 0x06, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x73, 0x12, 0x2e, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79,
-	0x6c, 0x69, 0x73, 0x74, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61, 0x72,
-	0x74, 0x69, 0x73, 0x74, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x09, 0x70,
-	0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x64, 0x41, 0x6c, 0x62, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x6c, 0x62, 0x22, 0x95, 0x03, 0x0a, 0x05, 0x41, 0x6c, 0x62,
-	0x75, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02,
-	0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x49, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05,
-	0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74,
-	0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x75, 0x62, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x75, 0x62, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x20,
-	0x0a, 0x0b, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x61, 0x74, 0x65,
-	0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x54, 0x79,
-	0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74,
-	0x12, 0x1c, 0x0a, 0x09, 0x76, 0x69, 0x65, 0x77, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x09, 0x76, 0x69, 0x65, 0x77, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c,
-	0x0a, 0x09, 0x74, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x09, 0x74, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x12, 0x1c, 0x0a, 0x09,
-	0x73, 0x79, 0x6e, 0x63, 0x53, 0x74, 0x61, 0x74, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x09, 0x73, 0x79, 0x6e, 0x63, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x69,
-	0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6c,
-	0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x77, 0x61, 0x74, 0x63,
-	0x68, 0x53, 0x74, 0x61, 0x74, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x77, 0x61,
-	0x74, 0x63, 0x68, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x72, 0x74, 0x69,
-	0x73, 0x74, 0x49, 0x64, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x61, 0x72, 0x74,
-	0x69, 0x73, 0x74, 0x49, 0x64, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x74,
-	0x79, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x02, 0x52, 0x07, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79,
-	0x22, 0xae, 0x01, 0x0a, 0x08, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1e, 0x0a,
-	0x0a, 0x70, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a,
-	0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69,
-	0x74, 0x6c, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x74, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69,
-	0x6c, 0x12, 0x22, 0x0a, 0x0c, 0x70, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x54, 0x79, 0x70,
-	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x70, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73,
-	0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x64,
-	0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x64,
-	0x73, 0x22, 0x5d, 0x0a, 0x11, 0x53, 0x79, 0x6e, 0x63, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x69, 0x74, 0x65, 0x49, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x73, 0x69, 0x74, 0x65, 0x49, 0x64, 0x12, 0x1a,
-	0x0a, 0x08, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x73,
-	0x41, 0x64, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x69, 0x73, 0x41, 0x64, 0x64,
-	0x22, 0x3e, 0x0a, 0x12, 0x53, 0x79, 0x6e, 0x63, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x07, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74,
-	0x2e, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x52, 0x07, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x73,
-	0x22, 0x66, 0x0a, 0x16, 0x52, 0x65, 0x61, 0x64, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x41, 0x6c,
-	0x62, 0x75, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x69,
-	0x74, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x73, 0x69, 0x74, 0x65,
-	0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x49, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x49, 0x64, 0x12, 0x18,
-	0x0a, 0x07, 0x6e, 0x65, 0x77, 0x4f, 0x6e, 0x6c, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x07, 0x6e, 0x65, 0x77, 0x4f, 0x6e, 0x6c, 0x79, 0x22, 0x74, 0x0a, 0x17, 0x52, 0x65, 0x61, 0x64,
-	0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x08, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x2e, 0x41,
-	0x6c, 0x62, 0x75, 0x6d, 0x52, 0x08, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x12, 0x2e,
-	0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x10, 0x2e, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x6c,
-	0x69, 0x73, 0x74, 0x52, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x73, 0x22, 0x49,
-	0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x69, 0x74, 0x65, 0x49, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x73, 0x69, 0x74, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a,
-	0x08, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x49, 0x64, 0x22, 0x3a, 0x0a, 0x14, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x6f, 0x77, 0x73, 0x41, 0x66, 0x66, 0x65, 0x63, 0x74, 0x65,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x72, 0x6f, 0x77, 0x73, 0x41, 0x66, 0x66,
-	0x65, 0x63, 0x74, 0x65, 0x64, 0x22, 0x5b, 0x0a, 0x11, 0x53, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x6e,
-	0x6e, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x69,
-	0x74, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x73, 0x69, 0x74, 0x65,
-	0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05,
-	0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x73, 0x74, 0x61,
-	0x74, 0x65, 0x22, 0x38, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x64,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x6f, 0x77, 0x73,
-	0x41, 0x66, 0x66, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c,
-	0x72, 0x6f, 0x77, 0x73, 0x41, 0x66, 0x66, 0x65, 0x63, 0x74, 0x65, 0x64, 0x22, 0x2a, 0x0a, 0x10,
-	0x43, 0x6c, 0x65, 0x61, 0x72, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x16, 0x0a, 0x06, 0x73, 0x69, 0x74, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x06, 0x73, 0x69, 0x74, 0x65, 0x49, 0x64, 0x22, 0x37, 0x0a, 0x11, 0x43, 0x6c, 0x65, 0x61,
-	0x72, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a,
-	0x0c, 0x72, 0x6f, 0x77, 0x73, 0x41, 0x66, 0x66, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x0c, 0x72, 0x6f, 0x77, 0x73, 0x41, 0x66, 0x66, 0x65, 0x63, 0x74, 0x65,
-	0x64, 0x22, 0x83, 0x01, 0x0a, 0x15, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x41, 0x6c,
-	0x62, 0x75, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73,
-	0x69, 0x74, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x73, 0x69, 0x74,
-	0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x49, 0x64, 0x73, 0x18,
-	0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x49, 0x64, 0x73, 0x12,
-	0x22, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x51, 0x75, 0x61, 0x6c,
-	0x69, 0x74, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x73, 0x50, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x04, 0x69, 0x73, 0x50, 0x6c, 0x22, 0x6f, 0x0a, 0x15, 0x44, 0x6f, 0x77, 0x6e, 0x6c,
-	0x6f, 0x61, 0x64, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x16, 0x0a, 0x06, 0x73, 0x69, 0x74, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x06, 0x73, 0x69, 0x74, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x72, 0x74, 0x69,
-	0x73, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x72, 0x74, 0x69,
-	0x73, 0x74, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x51, 0x75, 0x61,
-	0x6c, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x63,
-	0x6b, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x22, 0xa7, 0x01, 0x0a, 0x16, 0x44, 0x6f, 0x77,
-	0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x0a, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x65,
-	0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74,
-	0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64,
-	0x65, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61,
-	0x64, 0x65, 0x64, 0x1a, 0x3d, 0x0a, 0x0f, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x65,
-	0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
-	0x38, 0x01, 0x22, 0xa7, 0x01, 0x0a, 0x16, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x54,
-	0x72, 0x61, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a,
-	0x0a, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x2e, 0x2e, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c,
-	0x6f, 0x61, 0x64, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x52, 0x0a, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x1a, 0x3d, 0x0a,
-	0x0f, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
-	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x2b, 0x0a, 0x11,
-	0x4c, 0x69, 0x73, 0x74, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x69, 0x74, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x06, 0x73, 0x69, 0x74, 0x65, 0x49, 0x64, 0x22, 0x3e, 0x0a, 0x12, 0x4c, 0x69, 0x73,
-	0x74, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x28, 0x0a, 0x07, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x0e, 0x2e, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74,
-	0x52, 0x07, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x73, 0x32, 0xe2, 0x04, 0x0a, 0x0d, 0x41, 0x72,
-	0x74, 0x69, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x43, 0x0a, 0x0a, 0x53,
-	0x79, 0x6e, 0x63, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x12, 0x19, 0x2e, 0x61, 0x72, 0x74, 0x69,
-	0x73, 0x74, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x2e, 0x53, 0x79,
-	0x6e, 0x63, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x53, 0x0a, 0x10, 0x52, 0x65, 0x61, 0x64, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x41, 0x6c,
-	0x62, 0x75, 0x6d, 0x73, 0x12, 0x1e, 0x2e, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x2e, 0x52, 0x65,
-	0x61, 0x64, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x2e, 0x52, 0x65,
-	0x61, 0x64, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41,
-	0x72, 0x74, 0x69, 0x73, 0x74, 0x12, 0x1b, 0x2e, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x2e, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x43, 0x0a, 0x0a, 0x53, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x12, 0x19,
-	0x2e, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x6e,
-	0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x72, 0x74, 0x69,
-	0x73, 0x74, 0x2e, 0x53, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x09, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x53, 0x79,
-	0x6e, 0x63, 0x12, 0x18, 0x2e, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x2e, 0x43, 0x6c, 0x65, 0x61,
-	0x72, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x61,
-	0x72, 0x74, 0x69, 0x73, 0x74, 0x2e, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x53, 0x79, 0x6e, 0x63, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x0e, 0x44, 0x6f, 0x77, 0x6e, 0x6c,
-	0x6f, 0x61, 0x64, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x73, 0x12, 0x1d, 0x2e, 0x61, 0x72, 0x74, 0x69,
-	0x73, 0x74, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x41, 0x6c, 0x62, 0x75, 0x6d,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x61, 0x72, 0x74, 0x69, 0x73,
-	0x74, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x0e, 0x44, 0x6f, 0x77, 0x6e,
-	0x6c, 0x6f, 0x61, 0x64, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x12, 0x1d, 0x2e, 0x61, 0x72, 0x74,
-	0x69, 0x73, 0x74, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x41, 0x72, 0x74, 0x69,
-	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x61, 0x72, 0x74, 0x69,
-	0x73, 0x74, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x41, 0x6c, 0x62, 0x75, 0x6d,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x0a, 0x4c, 0x69, 0x73,
-	0x74, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x12, 0x19, 0x2e, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74,
-	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74,
-	0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x26,
-	0x5a, 0x24, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x76, 0x30, 0x76,
-	0x63, 0x2f, 0x67, 0x6f, 0x2d, 0x6d, 0x75, 0x73, 0x69, 0x63, 0x2d, 0x67, 0x72, 0x70, 0x63, 0x3b,
-	0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
-}
-
-var (
-	file_artist_proto_rawDescOnce sync.Once
-	file_artist_proto_rawDescData = file_artist_proto_rawDesc
-)
-
-func file_artist_proto_rawDescGZIP() []byte {
-	file_artist_proto_rawDescOnce.Do(func() {
-		file_artist_proto_rawDescData = protoimpl.X.CompressGZIP(file_artist_proto_rawDescData)
-	})
-	return file_artist_proto_rawDescData
-}
-
-var file_artist_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
-var file_artist_proto_goTypes = []interface{}{
-	(*Artist)(nil),                  // 0: artist.Artist
-	(*Album)(nil),                   // 1: artist.Album
-	(*Playlist)(nil),                // 2: artist.Playlist
-	(*SyncArtistRequest)(nil),       // 3: artist.SyncArtistRequest
-	(*SyncArtistResponse)(nil),      // 4: artist.SyncArtistResponse
-	(*ReadArtistAlbumRequest)(nil),  // 5: artist.ReadArtistAlbumRequest
-	(*ReadArtistAlbumResponse)(nil), // 6: artist.ReadArtistAlbumResponse
-	(*DeleteArtistRequest)(nil),     // 7: artist.DeleteArtistRequest
-	(*DeleteArtistResponse)(nil),    // 8: artist.DeleteArtistResponse
-	(*SetPlannedRequest)(nil),       // 9: artist.SetPlannedRequest
-	(*SetPlannedResponse)(nil),      // 10: artist.SetPlannedResponse
-	(*ClearSyncRequest)(nil),        // 11: artist.ClearSyncRequest
-	(*ClearSyncResponse)(nil),       // 12: artist.ClearSyncResponse
-	(*DownloadAlbumsRequest)(nil),   // 13: artist.DownloadAlbumsRequest
-	(*DownloadArtistRequest)(nil),   // 14: artist.DownloadArtistRequest
-	(*DownloadAlbumsResponse)(nil),  // 15: artist.DownloadAlbumsResponse
-	(*DownloadTracksResponse)(nil),  // 16: artist.DownloadTracksResponse
-	(*ListArtistRequest)(nil),       // 17: artist.ListArtistRequest
-	(*ListArtistResponse)(nil),      // 18: artist.ListArtistResponse
-	nil,                             // 19: artist.DownloadAlbumsResponse.DownloadedEntry
-	nil,                             // 20: artist.DownloadTracksResponse.DownloadedEntry
-}
-var file_artist_proto_depIdxs = []int32{
-	1,  // 0: artist.Artist.albums:type_name -> artist.Album
-	2,  // 1: artist.Artist.playlists:type_name -> artist.Playlist
-	0,  // 2: artist.SyncArtistResponse.artists:type_name -> artist.Artist
-	1,  // 3: artist.ReadArtistAlbumResponse.releases:type_name -> artist.Album
-	2,  // 4: artist.ReadArtistAlbumResponse.playlists:type_name -> artist.Playlist
-	19, // 5: artist.DownloadAlbumsResponse.Downloaded:type_name -> artist.DownloadAlbumsResponse.DownloadedEntry
-	20, // 6: artist.DownloadTracksResponse.Downloaded:type_name -> artist.DownloadTracksResponse.DownloadedEntry
-	0,  // 7: artist.ListArtistResponse.artists:type_name -> artist.Artist
-	3,  // 8: artist.ArtistService.SyncArtist:input_type -> artist.SyncArtistRequest
-	5,  // 9: artist.ArtistService.ReadArtistAlbums:input_type -> artist.ReadArtistAlbumRequest
-	7,  // 10: artist.ArtistService.DeleteArtist:input_type -> artist.DeleteArtistRequest
-	9,  // 11: artist.ArtistService.SetPlanned:input_type -> artist.SetPlannedRequest
-	11, // 12: artist.ArtistService.ClearSync:input_type -> artist.ClearSyncRequest
-	13, // 13: artist.ArtistService.DownloadAlbums:input_type -> artist.DownloadAlbumsRequest
-	14, // 14: artist.ArtistService.DownloadArtist:input_type -> artist.DownloadArtistRequest
-	17, // 15: artist.ArtistService.ListArtist:input_type -> artist.ListArtistRequest
-	4,  // 16: artist.ArtistService.SyncArtist:output_type -> artist.SyncArtistResponse
-	6,  // 17: artist.ArtistService.ReadArtistAlbums:output_type -> artist.ReadArtistAlbumResponse
-	8,  // 18: artist.ArtistService.DeleteArtist:output_type -> artist.DeleteArtistResponse
-	10, // 19: artist.ArtistService.SetPlanned:output_type -> artist.SetPlannedResponse
-	12, // 20: artist.ArtistService.ClearSync:output_type -> artist.ClearSyncResponse
-	15, // 21: artist.ArtistService.DownloadAlbums:output_type -> artist.DownloadAlbumsResponse
-	15, // 22: artist.ArtistService.DownloadArtist:output_type -> artist.DownloadAlbumsResponse
-	18, // 23: artist.ArtistService.ListArtist:output_type -> artist.ListArtistResponse
-	16, // [16:24] is the sub-list for method output_type
-	8,  // [8:16] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
-}
-
-func init() { file_artist_proto_init() }
-func file_artist_proto_init() {
-	if File_artist_proto != nil {
-		return
-	}
-	if !protoimpl.UnsafeEnabled {
-		file_artist_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Artist); i {
+		file_artist_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SyncSchedule); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1515,8 +6851,8 @@ func file_artist_proto_init() {
 				return nil
 			}
 		}
-		file_artist_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Album); i {
+		file_artist_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SyncScheduleRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1527,8 +6863,8 @@ func file_artist_proto_init() {
 				return nil
 			}
 		}
-		file_artist_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Playlist); i {
+		file_artist_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SyncScheduleResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1539,8 +6875,8 @@ func file_artist_proto_init() {
 				return nil
 			}
 		}
-		file_artist_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SyncArtistRequest); i {
+		file_artist_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DownloadRule); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1551,8 +6887,8 @@ func file_artist_proto_init() {
 				return nil
 			}
 		}
-		file_artist_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SyncArtistResponse); i {
+		file_artist_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DownloadRuleRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1563,8 +6899,8 @@ func file_artist_proto_init() {
 				return nil
 			}
 		}
-		file_artist_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReadArtistAlbumRequest); i {
+		file_artist_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDownloadRulesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1575,8 +6911,8 @@ func file_artist_proto_init() {
 				return nil
 			}
 		}
-		file_artist_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReadArtistAlbumResponse); i {
+		file_artist_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDownloadRulesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1587,8 +6923,8 @@ func file_artist_proto_init() {
 				return nil
 			}
 		}
-		file_artist_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteArtistRequest); i {
+		file_artist_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteDownloadRuleResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1599,8 +6935,8 @@ func file_artist_proto_init() {
 				return nil
 			}
 		}
-		file_artist_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteArtistResponse); i {
+		file_artist_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PreviewDownloadRulesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1611,8 +6947,8 @@ func file_artist_proto_init() {
 				return nil
 			}
 		}
-		file_artist_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetPlannedRequest); i {
+		file_artist_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DownloadDecision); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1623,8 +6959,8 @@ func file_artist_proto_init() {
 				return nil
 			}
 		}
-		file_artist_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetPlannedResponse); i {
+		file_artist_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PreviewDownloadRulesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1635,8 +6971,8 @@ func file_artist_proto_init() {
 				return nil
 			}
 		}
-		file_artist_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ClearSyncRequest); i {
+		file_artist_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetSyncSettingsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1647,8 +6983,8 @@ func file_artist_proto_init() {
 				return nil
 			}
 		}
-		file_artist_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ClearSyncResponse); i {
+		file_artist_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Site); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1659,8 +6995,8 @@ func file_artist_proto_init() {
 				return nil
 			}
 		}
-		file_artist_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DownloadAlbumsRequest); i {
+		file_artist_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSitesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1671,8 +7007,8 @@ func file_artist_proto_init() {
 				return nil
 			}
 		}
-		file_artist_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DownloadArtistRequest); i {
+		file_artist_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSitesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1683,8 +7019,8 @@ func file_artist_proto_init() {
 				return nil
 			}
 		}
-		file_artist_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DownloadAlbumsResponse); i {
+		file_artist_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateSiteCredentialsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1695,8 +7031,8 @@ func file_artist_proto_init() {
 				return nil
 			}
 		}
-		file_artist_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DownloadTracksResponse); i {
+		file_artist_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidateSiteCredentialsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1707,8 +7043,8 @@ func file_artist_proto_init() {
 				return nil
 			}
 		}
-		file_artist_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListArtistRequest); i {
+		file_artist_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidateSiteCredentialsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1719,8 +7055,32 @@ func file_artist_proto_init() {
 				return nil
 			}
 		}
-		file_artist_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListArtistResponse); i {
+		file_artist_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuotaRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_artist_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuotaUsage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_artist_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuotaResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1732,15 +7092,17 @@ func file_artist_proto_init() {
 			}
 		}
 	}
+	file_artist_proto_msgTypes[67].OneofWrappers = []interface{}{}
+	file_artist_proto_msgTypes[68].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_artist_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   21,
+			NumMessages:   76,
 			NumExtensions: 0,
-			NumServices:   1,
+			NumServices:   2,
 		},
 		GoTypes:           file_artist_proto_goTypes,
 		DependencyIndexes: file_artist_proto_depIdxs,
@@ -24,13 +24,38 @@ const _ = grpc.SupportPackageIsVersion7
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type ArtistServiceClient interface {
 	SyncArtist(ctx context.Context, in *SyncArtistRequest, opts ...grpc.CallOption) (*SyncArtistResponse, error)
+	SyncArtistStream(ctx context.Context, in *SyncArtistRequest, opts ...grpc.CallOption) (ArtistService_SyncArtistStreamClient, error)
 	ReadArtistAlbums(ctx context.Context, in *ReadArtistAlbumRequest, opts ...grpc.CallOption) (*ReadArtistAlbumResponse, error)
+	ReadAlbumTracks(ctx context.Context, in *ReadAlbumTracksRequest, opts ...grpc.CallOption) (*ReadAlbumTracksResponse, error)
 	DeleteArtist(ctx context.Context, in *DeleteArtistRequest, opts ...grpc.CallOption) (*DeleteArtistResponse, error)
 	SetPlanned(ctx context.Context, in *SetPlannedRequest, opts ...grpc.CallOption) (*SetPlannedResponse, error)
 	ClearSync(ctx context.Context, in *ClearSyncRequest, opts ...grpc.CallOption) (*ClearSyncResponse, error)
+	AcknowledgeItems(ctx context.Context, in *AcknowledgeItemsRequest, opts ...grpc.CallOption) (*AcknowledgeResponse, error)
+	AcknowledgeArtist(ctx context.Context, in *AcknowledgeArtistRequest, opts ...grpc.CallOption) (*AcknowledgeResponse, error)
+	AcknowledgeOlderThan(ctx context.Context, in *AcknowledgeOlderThanRequest, opts ...grpc.CallOption) (*AcknowledgeResponse, error)
 	DownloadAlbums(ctx context.Context, in *DownloadAlbumsRequest, opts ...grpc.CallOption) (*DownloadAlbumsResponse, error)
 	DownloadArtist(ctx context.Context, in *DownloadArtistRequest, opts ...grpc.CallOption) (*DownloadAlbumsResponse, error)
+	DownloadAlbumsStream(ctx context.Context, in *DownloadAlbumsRequest, opts ...grpc.CallOption) (ArtistService_DownloadAlbumsStreamClient, error)
 	ListArtist(ctx context.Context, in *ListArtistRequest, opts ...grpc.CallOption) (*ListArtistResponse, error)
+	SubmitJob(ctx context.Context, in *SubmitJobRequest, opts ...grpc.CallOption) (*Job, error)
+	GetJob(ctx context.Context, in *JobRequest, opts ...grpc.CallOption) (*Job, error)
+	ListJobs(ctx context.Context, in *ListJobsRequest, opts ...grpc.CallOption) (*ListJobsResponse, error)
+	CancelJob(ctx context.Context, in *JobRequest, opts ...grpc.CallOption) (*Job, error)
+	RetryJob(ctx context.Context, in *JobRequest, opts ...grpc.CallOption) (*Job, error)
+	BulkAddArtists(ctx context.Context, in *BulkAddArtistsRequest, opts ...grpc.CallOption) (*Job, error)
+	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error)
+	SuggestArtists(ctx context.Context, in *SuggestArtistsRequest, opts ...grpc.CallOption) (*SuggestArtistsResponse, error)
+	RefreshVideoStats(ctx context.Context, in *RefreshVideoStatsRequest, opts ...grpc.CallOption) (*RefreshVideoStatsResponse, error)
+	GetVideoStats(ctx context.Context, in *VideoStatsRequest, opts ...grpc.CallOption) (*VideoStatsResponse, error)
+	SetSyncSettings(ctx context.Context, in *SetSyncSettingsRequest, opts ...grpc.CallOption) (*SyncSettings, error)
+	ListSyncRuns(ctx context.Context, in *ListSyncRunsRequest, opts ...grpc.CallOption) (*ListSyncRunsResponse, error)
+	GetSyncRun(ctx context.Context, in *SyncRunRequest, opts ...grpc.CallOption) (*SyncRun, error)
+	GetSyncSchedule(ctx context.Context, in *SyncScheduleRequest, opts ...grpc.CallOption) (*SyncScheduleResponse, error)
+	CreateDownloadRule(ctx context.Context, in *DownloadRule, opts ...grpc.CallOption) (*DownloadRule, error)
+	UpdateDownloadRule(ctx context.Context, in *DownloadRule, opts ...grpc.CallOption) (*DownloadRule, error)
+	DeleteDownloadRule(ctx context.Context, in *DownloadRuleRequest, opts ...grpc.CallOption) (*DeleteDownloadRuleResponse, error)
+	ListDownloadRules(ctx context.Context, in *ListDownloadRulesRequest, opts ...grpc.CallOption) (*ListDownloadRulesResponse, error)
+	PreviewDownloadRules(ctx context.Context, in *PreviewDownloadRulesRequest, opts ...grpc.CallOption) (*PreviewDownloadRulesResponse, error)
 }
 
 type artistServiceClient struct {
@@ -50,6 +75,38 @@ func (c *artistServiceClient) SyncArtist(ctx context.Context, in *SyncArtistRequ
 	return out, nil
 }
 
+func (c *artistServiceClient) SyncArtistStream(ctx context.Context, in *SyncArtistRequest, opts ...grpc.CallOption) (ArtistService_SyncArtistStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ArtistService_ServiceDesc.Streams[0], "/artist.ArtistService/SyncArtistStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &artistServiceSyncArtistStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ArtistService_SyncArtistStreamClient interface {
+	Recv() (*SyncArtistEvent, error)
+	grpc.ClientStream
+}
+
+type artistServiceSyncArtistStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *artistServiceSyncArtistStreamClient) Recv() (*SyncArtistEvent, error) {
+	m := new(SyncArtistEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *artistServiceClient) ReadArtistAlbums(ctx context.Context, in *ReadArtistAlbumRequest, opts ...grpc.CallOption) (*ReadArtistAlbumResponse, error) {
 	out := new(ReadArtistAlbumResponse)
 	err := c.cc.Invoke(ctx, "/artist.ArtistService/ReadArtistAlbums", in, out, opts...)
@@ -59,6 +116,15 @@ func (c *artistServiceClient) ReadArtistAlbums(ctx context.Context, in *ReadArti
 	return out, nil
 }
 
+func (c *artistServiceClient) ReadAlbumTracks(ctx context.Context, in *ReadAlbumTracksRequest, opts ...grpc.CallOption) (*ReadAlbumTracksResponse, error) {
+	out := new(ReadAlbumTracksResponse)
+	err := c.cc.Invoke(ctx, "/artist.ArtistService/ReadAlbumTracks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *artistServiceClient) DeleteArtist(ctx context.Context, in *DeleteArtistRequest, opts ...grpc.CallOption) (*DeleteArtistResponse, error) {
 	out := new(DeleteArtistResponse)
 	err := c.cc.Invoke(ctx, "/artist.ArtistService/DeleteArtist", in, out, opts...)
@@ -86,6 +152,33 @@ func (c *artistServiceClient) ClearSync(ctx context.Context, in *ClearSyncReques
 	return out, nil
 }
 
+func (c *artistServiceClient) AcknowledgeItems(ctx context.Context, in *AcknowledgeItemsRequest, opts ...grpc.CallOption) (*AcknowledgeResponse, error) {
+	out := new(AcknowledgeResponse)
+	err := c.cc.Invoke(ctx, "/artist.ArtistService/AcknowledgeItems", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *artistServiceClient) AcknowledgeArtist(ctx context.Context, in *AcknowledgeArtistRequest, opts ...grpc.CallOption) (*AcknowledgeResponse, error) {
+	out := new(AcknowledgeResponse)
+	err := c.cc.Invoke(ctx, "/artist.ArtistService/AcknowledgeArtist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *artistServiceClient) AcknowledgeOlderThan(ctx context.Context, in *AcknowledgeOlderThanRequest, opts ...grpc.CallOption) (*AcknowledgeResponse, error) {
+	out := new(AcknowledgeResponse)
+	err := c.cc.Invoke(ctx, "/artist.ArtistService/AcknowledgeOlderThan", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *artistServiceClient) DownloadAlbums(ctx context.Context, in *DownloadAlbumsRequest, opts ...grpc.CallOption) (*DownloadAlbumsResponse, error) {
 	out := new(DownloadAlbumsResponse)
 	err := c.cc.Invoke(ctx, "/artist.ArtistService/DownloadAlbums", in, out, opts...)
@@ -104,6 +197,38 @@ func (c *artistServiceClient) DownloadArtist(ctx context.Context, in *DownloadAr
 	return out, nil
 }
 
+func (c *artistServiceClient) DownloadAlbumsStream(ctx context.Context, in *DownloadAlbumsRequest, opts ...grpc.CallOption) (ArtistService_DownloadAlbumsStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ArtistService_ServiceDesc.Streams[1], "/artist.ArtistService/DownloadAlbumsStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &artistServiceDownloadAlbumsStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ArtistService_DownloadAlbumsStreamClient interface {
+	Recv() (*DownloadEvent, error)
+	grpc.ClientStream
+}
+
+type artistServiceDownloadAlbumsStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *artistServiceDownloadAlbumsStreamClient) Recv() (*DownloadEvent, error) {
+	m := new(DownloadEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *artistServiceClient) ListArtist(ctx context.Context, in *ListArtistRequest, opts ...grpc.CallOption) (*ListArtistResponse, error) {
 	out := new(ListArtistResponse)
 	err := c.cc.Invoke(ctx, "/artist.ArtistService/ListArtist", in, out, opts...)
@@ -113,18 +238,214 @@ func (c *artistServiceClient) ListArtist(ctx context.Context, in *ListArtistRequ
 	return out, nil
 }
 
+func (c *artistServiceClient) SubmitJob(ctx context.Context, in *SubmitJobRequest, opts ...grpc.CallOption) (*Job, error) {
+	out := new(Job)
+	err := c.cc.Invoke(ctx, "/artist.ArtistService/SubmitJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *artistServiceClient) GetJob(ctx context.Context, in *JobRequest, opts ...grpc.CallOption) (*Job, error) {
+	out := new(Job)
+	err := c.cc.Invoke(ctx, "/artist.ArtistService/GetJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *artistServiceClient) ListJobs(ctx context.Context, in *ListJobsRequest, opts ...grpc.CallOption) (*ListJobsResponse, error) {
+	out := new(ListJobsResponse)
+	err := c.cc.Invoke(ctx, "/artist.ArtistService/ListJobs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *artistServiceClient) CancelJob(ctx context.Context, in *JobRequest, opts ...grpc.CallOption) (*Job, error) {
+	out := new(Job)
+	err := c.cc.Invoke(ctx, "/artist.ArtistService/CancelJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *artistServiceClient) RetryJob(ctx context.Context, in *JobRequest, opts ...grpc.CallOption) (*Job, error) {
+	out := new(Job)
+	err := c.cc.Invoke(ctx, "/artist.ArtistService/RetryJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *artistServiceClient) BulkAddArtists(ctx context.Context, in *BulkAddArtistsRequest, opts ...grpc.CallOption) (*Job, error) {
+	out := new(Job)
+	err := c.cc.Invoke(ctx, "/artist.ArtistService/BulkAddArtists", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *artistServiceClient) Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error) {
+	out := new(SearchResponse)
+	err := c.cc.Invoke(ctx, "/artist.ArtistService/Search", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *artistServiceClient) SuggestArtists(ctx context.Context, in *SuggestArtistsRequest, opts ...grpc.CallOption) (*SuggestArtistsResponse, error) {
+	out := new(SuggestArtistsResponse)
+	err := c.cc.Invoke(ctx, "/artist.ArtistService/SuggestArtists", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *artistServiceClient) RefreshVideoStats(ctx context.Context, in *RefreshVideoStatsRequest, opts ...grpc.CallOption) (*RefreshVideoStatsResponse, error) {
+	out := new(RefreshVideoStatsResponse)
+	err := c.cc.Invoke(ctx, "/artist.ArtistService/RefreshVideoStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *artistServiceClient) GetVideoStats(ctx context.Context, in *VideoStatsRequest, opts ...grpc.CallOption) (*VideoStatsResponse, error) {
+	out := new(VideoStatsResponse)
+	err := c.cc.Invoke(ctx, "/artist.ArtistService/GetVideoStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *artistServiceClient) SetSyncSettings(ctx context.Context, in *SetSyncSettingsRequest, opts ...grpc.CallOption) (*SyncSettings, error) {
+	out := new(SyncSettings)
+	err := c.cc.Invoke(ctx, "/artist.ArtistService/SetSyncSettings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *artistServiceClient) ListSyncRuns(ctx context.Context, in *ListSyncRunsRequest, opts ...grpc.CallOption) (*ListSyncRunsResponse, error) {
+	out := new(ListSyncRunsResponse)
+	err := c.cc.Invoke(ctx, "/artist.ArtistService/ListSyncRuns", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *artistServiceClient) GetSyncRun(ctx context.Context, in *SyncRunRequest, opts ...grpc.CallOption) (*SyncRun, error) {
+	out := new(SyncRun)
+	err := c.cc.Invoke(ctx, "/artist.ArtistService/GetSyncRun", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *artistServiceClient) GetSyncSchedule(ctx context.Context, in *SyncScheduleRequest, opts ...grpc.CallOption) (*SyncScheduleResponse, error) {
+	out := new(SyncScheduleResponse)
+	err := c.cc.Invoke(ctx, "/artist.ArtistService/GetSyncSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *artistServiceClient) CreateDownloadRule(ctx context.Context, in *DownloadRule, opts ...grpc.CallOption) (*DownloadRule, error) {
+	out := new(DownloadRule)
+	err := c.cc.Invoke(ctx, "/artist.ArtistService/CreateDownloadRule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *artistServiceClient) UpdateDownloadRule(ctx context.Context, in *DownloadRule, opts ...grpc.CallOption) (*DownloadRule, error) {
+	out := new(DownloadRule)
+	err := c.cc.Invoke(ctx, "/artist.ArtistService/UpdateDownloadRule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *artistServiceClient) DeleteDownloadRule(ctx context.Context, in *DownloadRuleRequest, opts ...grpc.CallOption) (*DeleteDownloadRuleResponse, error) {
+	out := new(DeleteDownloadRuleResponse)
+	err := c.cc.Invoke(ctx, "/artist.ArtistService/DeleteDownloadRule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *artistServiceClient) ListDownloadRules(ctx context.Context, in *ListDownloadRulesRequest, opts ...grpc.CallOption) (*ListDownloadRulesResponse, error) {
+	out := new(ListDownloadRulesResponse)
+	err := c.cc.Invoke(ctx, "/artist.ArtistService/ListDownloadRules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *artistServiceClient) PreviewDownloadRules(ctx context.Context, in *PreviewDownloadRulesRequest, opts ...grpc.CallOption) (*PreviewDownloadRulesResponse, error) {
+	out := new(PreviewDownloadRulesResponse)
+	err := c.cc.Invoke(ctx, "/artist.ArtistService/PreviewDownloadRules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ArtistServiceServer is the server API for ArtistService service.
 // All implementations must embed UnimplementedArtistServiceServer
 // for forward compatibility
 type ArtistServiceServer interface {
 	SyncArtist(context.Context, *SyncArtistRequest) (*SyncArtistResponse, error)
+	SyncArtistStream(*SyncArtistRequest, ArtistService_SyncArtistStreamServer) error
 	ReadArtistAlbums(context.Context, *ReadArtistAlbumRequest) (*ReadArtistAlbumResponse, error)
+	ReadAlbumTracks(context.Context, *ReadAlbumTracksRequest) (*ReadAlbumTracksResponse, error)
 	DeleteArtist(context.Context, *DeleteArtistRequest) (*DeleteArtistResponse, error)
 	SetPlanned(context.Context, *SetPlannedRequest) (*SetPlannedResponse, error)
 	ClearSync(context.Context, *ClearSyncRequest) (*ClearSyncResponse, error)
+	AcknowledgeItems(context.Context, *AcknowledgeItemsRequest) (*AcknowledgeResponse, error)
+	AcknowledgeArtist(context.Context, *AcknowledgeArtistRequest) (*AcknowledgeResponse, error)
+	AcknowledgeOlderThan(context.Context, *AcknowledgeOlderThanRequest) (*AcknowledgeResponse, error)
 	DownloadAlbums(context.Context, *DownloadAlbumsRequest) (*DownloadAlbumsResponse, error)
 	DownloadArtist(context.Context, *DownloadArtistRequest) (*DownloadAlbumsResponse, error)
+	DownloadAlbumsStream(*DownloadAlbumsRequest, ArtistService_DownloadAlbumsStreamServer) error
 	ListArtist(context.Context, *ListArtistRequest) (*ListArtistResponse, error)
+	SubmitJob(context.Context, *SubmitJobRequest) (*Job, error)
+	GetJob(context.Context, *JobRequest) (*Job, error)
+	ListJobs(context.Context, *ListJobsRequest) (*ListJobsResponse, error)
+	CancelJob(context.Context, *JobRequest) (*Job, error)
+	RetryJob(context.Context, *JobRequest) (*Job, error)
+	BulkAddArtists(context.Context, *BulkAddArtistsRequest) (*Job, error)
+	Search(context.Context, *SearchRequest) (*SearchResponse, error)
+	SuggestArtists(context.Context, *SuggestArtistsRequest) (*SuggestArtistsResponse, error)
+	RefreshVideoStats(context.Context, *RefreshVideoStatsRequest) (*RefreshVideoStatsResponse, error)
+	GetVideoStats(context.Context, *VideoStatsRequest) (*VideoStatsResponse, error)
+	SetSyncSettings(context.Context, *SetSyncSettingsRequest) (*SyncSettings, error)
+	ListSyncRuns(context.Context, *ListSyncRunsRequest) (*ListSyncRunsResponse, error)
+	GetSyncRun(context.Context, *SyncRunRequest) (*SyncRun, error)
+	GetSyncSchedule(context.Context, *SyncScheduleRequest) (*SyncScheduleResponse, error)
+	CreateDownloadRule(context.Context, *DownloadRule) (*DownloadRule, error)
+	UpdateDownloadRule(context.Context, *DownloadRule) (*DownloadRule, error)
+	DeleteDownloadRule(context.Context, *DownloadRuleRequest) (*DeleteDownloadRuleResponse, error)
+	ListDownloadRules(context.Context, *ListDownloadRulesRequest) (*ListDownloadRulesResponse, error)
+	PreviewDownloadRules(context.Context, *PreviewDownloadRulesRequest) (*PreviewDownloadRulesResponse, error)
 	mustEmbedUnimplementedArtistServiceServer()
 }
 
@@ -135,9 +456,15 @@ type UnimplementedArtistServiceServer struct {
 func (UnimplementedArtistServiceServer) SyncArtist(context.Context, *SyncArtistRequest) (*SyncArtistResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SyncArtist not implemented")
 }
+func (UnimplementedArtistServiceServer) SyncArtistStream(*SyncArtistRequest, ArtistService_SyncArtistStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method SyncArtistStream not implemented")
+}
 func (UnimplementedArtistServiceServer) ReadArtistAlbums(context.Context, *ReadArtistAlbumRequest) (*ReadArtistAlbumResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReadArtistAlbums not implemented")
 }
+func (UnimplementedArtistServiceServer) ReadAlbumTracks(context.Context, *ReadAlbumTracksRequest) (*ReadAlbumTracksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadAlbumTracks not implemented")
+}
 func (UnimplementedArtistServiceServer) DeleteArtist(context.Context, *DeleteArtistRequest) (*DeleteArtistResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteArtist not implemented")
 }
@@ -147,15 +474,84 @@ func (UnimplementedArtistServiceServer) SetPlanned(context.Context, *SetPlannedR
 func (UnimplementedArtistServiceServer) ClearSync(context.Context, *ClearSyncRequest) (*ClearSyncResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ClearSync not implemented")
 }
+func (UnimplementedArtistServiceServer) AcknowledgeItems(context.Context, *AcknowledgeItemsRequest) (*AcknowledgeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcknowledgeItems not implemented")
+}
+func (UnimplementedArtistServiceServer) AcknowledgeArtist(context.Context, *AcknowledgeArtistRequest) (*AcknowledgeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcknowledgeArtist not implemented")
+}
+func (UnimplementedArtistServiceServer) AcknowledgeOlderThan(context.Context, *AcknowledgeOlderThanRequest) (*AcknowledgeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcknowledgeOlderThan not implemented")
+}
 func (UnimplementedArtistServiceServer) DownloadAlbums(context.Context, *DownloadAlbumsRequest) (*DownloadAlbumsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DownloadAlbums not implemented")
 }
 func (UnimplementedArtistServiceServer) DownloadArtist(context.Context, *DownloadArtistRequest) (*DownloadAlbumsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DownloadArtist not implemented")
 }
+func (UnimplementedArtistServiceServer) DownloadAlbumsStream(*DownloadAlbumsRequest, ArtistService_DownloadAlbumsStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method DownloadAlbumsStream not implemented")
+}
 func (UnimplementedArtistServiceServer) ListArtist(context.Context, *ListArtistRequest) (*ListArtistResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListArtist not implemented")
 }
+func (UnimplementedArtistServiceServer) SubmitJob(context.Context, *SubmitJobRequest) (*Job, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitJob not implemented")
+}
+func (UnimplementedArtistServiceServer) GetJob(context.Context, *JobRequest) (*Job, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJob not implemented")
+}
+func (UnimplementedArtistServiceServer) ListJobs(context.Context, *ListJobsRequest) (*ListJobsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListJobs not implemented")
+}
+func (UnimplementedArtistServiceServer) CancelJob(context.Context, *JobRequest) (*Job, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelJob not implemented")
+}
+func (UnimplementedArtistServiceServer) RetryJob(context.Context, *JobRequest) (*Job, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetryJob not implemented")
+}
+func (UnimplementedArtistServiceServer) BulkAddArtists(context.Context, *BulkAddArtistsRequest) (*Job, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkAddArtists not implemented")
+}
+func (UnimplementedArtistServiceServer) Search(context.Context, *SearchRequest) (*SearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Search not implemented")
+}
+func (UnimplementedArtistServiceServer) SuggestArtists(context.Context, *SuggestArtistsRequest) (*SuggestArtistsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuggestArtists not implemented")
+}
+func (UnimplementedArtistServiceServer) RefreshVideoStats(context.Context, *RefreshVideoStatsRequest) (*RefreshVideoStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshVideoStats not implemented")
+}
+func (UnimplementedArtistServiceServer) GetVideoStats(context.Context, *VideoStatsRequest) (*VideoStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVideoStats not implemented")
+}
+func (UnimplementedArtistServiceServer) SetSyncSettings(context.Context, *SetSyncSettingsRequest) (*SyncSettings, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSyncSettings not implemented")
+}
+func (UnimplementedArtistServiceServer) ListSyncRuns(context.Context, *ListSyncRunsRequest) (*ListSyncRunsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSyncRuns not implemented")
+}
+func (UnimplementedArtistServiceServer) GetSyncRun(context.Context, *SyncRunRequest) (*SyncRun, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSyncRun not implemented")
+}
+func (UnimplementedArtistServiceServer) GetSyncSchedule(context.Context, *SyncScheduleRequest) (*SyncScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSyncSchedule not implemented")
+}
+func (UnimplementedArtistServiceServer) CreateDownloadRule(context.Context, *DownloadRule) (*DownloadRule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDownloadRule not implemented")
+}
+func (UnimplementedArtistServiceServer) UpdateDownloadRule(context.Context, *DownloadRule) (*DownloadRule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDownloadRule not implemented")
+}
+func (UnimplementedArtistServiceServer) DeleteDownloadRule(context.Context, *DownloadRuleRequest) (*DeleteDownloadRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDownloadRule not implemented")
+}
+func (UnimplementedArtistServiceServer) ListDownloadRules(context.Context, *ListDownloadRulesRequest) (*ListDownloadRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDownloadRules not implemented")
+}
+func (UnimplementedArtistServiceServer) PreviewDownloadRules(context.Context, *PreviewDownloadRulesRequest) (*PreviewDownloadRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewDownloadRules not implemented")
+}
 func (UnimplementedArtistServiceServer) mustEmbedUnimplementedArtistServiceServer() {}
 
 // UnsafeArtistServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -187,6 +583,27 @@ func _ArtistService_SyncArtist_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ArtistService_SyncArtistStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SyncArtistRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ArtistServiceServer).SyncArtistStream(m, &artistServiceSyncArtistStreamServer{stream})
+}
+
+type ArtistService_SyncArtistStreamServer interface {
+	Send(*SyncArtistEvent) error
+	grpc.ServerStream
+}
+
+type artistServiceSyncArtistStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *artistServiceSyncArtistStreamServer) Send(m *SyncArtistEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _ArtistService_ReadArtistAlbums_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ReadArtistAlbumRequest)
 	if err := dec(in); err != nil {
@@ -205,6 +622,24 @@ func _ArtistService_ReadArtistAlbums_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ArtistService_ReadAlbumTracks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadAlbumTracksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArtistServiceServer).ReadAlbumTracks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/artist.ArtistService/ReadAlbumTracks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArtistServiceServer).ReadAlbumTracks(ctx, req.(*ReadAlbumTracksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ArtistService_DeleteArtist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteArtistRequest)
 	if err := dec(in); err != nil {
@@ -259,87 +694,520 @@ func _ArtistService_ClearSync_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ArtistService_DownloadAlbums_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DownloadAlbumsRequest)
+func _ArtistService_AcknowledgeItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcknowledgeItemsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ArtistServiceServer).DownloadAlbums(ctx, in)
+		return srv.(ArtistServiceServer).AcknowledgeItems(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/artist.ArtistService/DownloadAlbums",
+		FullMethod: "/artist.ArtistService/AcknowledgeItems",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ArtistServiceServer).DownloadAlbums(ctx, req.(*DownloadAlbumsRequest))
+		return srv.(ArtistServiceServer).AcknowledgeItems(ctx, req.(*AcknowledgeItemsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ArtistService_DownloadArtist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DownloadArtistRequest)
+func _ArtistService_AcknowledgeArtist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcknowledgeArtistRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ArtistServiceServer).DownloadArtist(ctx, in)
+		return srv.(ArtistServiceServer).AcknowledgeArtist(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/artist.ArtistService/DownloadArtist",
+		FullMethod: "/artist.ArtistService/AcknowledgeArtist",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ArtistServiceServer).DownloadArtist(ctx, req.(*DownloadArtistRequest))
+		return srv.(ArtistServiceServer).AcknowledgeArtist(ctx, req.(*AcknowledgeArtistRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ArtistService_ListArtist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListArtistRequest)
+func _ArtistService_AcknowledgeOlderThan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcknowledgeOlderThanRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ArtistServiceServer).ListArtist(ctx, in)
+		return srv.(ArtistServiceServer).AcknowledgeOlderThan(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/artist.ArtistService/ListArtist",
+		FullMethod: "/artist.ArtistService/AcknowledgeOlderThan",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ArtistServiceServer).ListArtist(ctx, req.(*ListArtistRequest))
+		return srv.(ArtistServiceServer).AcknowledgeOlderThan(ctx, req.(*AcknowledgeOlderThanRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// ArtistService_ServiceDesc is the grpc.ServiceDesc for ArtistService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var ArtistService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "artist.ArtistService",
-	HandlerType: (*ArtistServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "SyncArtist",
-			Handler:    _ArtistService_SyncArtist_Handler,
-		},
-		{
-			MethodName: "ReadArtistAlbums",
-			Handler:    _ArtistService_ReadArtistAlbums_Handler,
-		},
-		{
-			MethodName: "DeleteArtist",
-			Handler:    _ArtistService_DeleteArtist_Handler,
-		},
-		{
-			MethodName: "SetPlanned",
-			Handler:    _ArtistService_SetPlanned_Handler,
+func _ArtistService_DownloadAlbums_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DownloadAlbumsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArtistServiceServer).DownloadAlbums(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/artist.ArtistService/DownloadAlbums",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArtistServiceServer).DownloadAlbums(ctx, req.(*DownloadAlbumsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArtistService_DownloadArtist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DownloadArtistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArtistServiceServer).DownloadArtist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/artist.ArtistService/DownloadArtist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArtistServiceServer).DownloadArtist(ctx, req.(*DownloadArtistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArtistService_DownloadAlbumsStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DownloadAlbumsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ArtistServiceServer).DownloadAlbumsStream(m, &artistServiceDownloadAlbumsStreamServer{stream})
+}
+
+type ArtistService_DownloadAlbumsStreamServer interface {
+	Send(*DownloadEvent) error
+	grpc.ServerStream
+}
+
+type artistServiceDownloadAlbumsStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *artistServiceDownloadAlbumsStreamServer) Send(m *DownloadEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ArtistService_ListArtist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListArtistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArtistServiceServer).ListArtist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/artist.ArtistService/ListArtist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArtistServiceServer).ListArtist(ctx, req.(*ListArtistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArtistService_SubmitJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArtistServiceServer).SubmitJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/artist.ArtistService/SubmitJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArtistServiceServer).SubmitJob(ctx, req.(*SubmitJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArtistService_GetJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArtistServiceServer).GetJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/artist.ArtistService/GetJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArtistServiceServer).GetJob(ctx, req.(*JobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArtistService_ListJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListJobsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArtistServiceServer).ListJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/artist.ArtistService/ListJobs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArtistServiceServer).ListJobs(ctx, req.(*ListJobsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArtistService_CancelJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArtistServiceServer).CancelJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/artist.ArtistService/CancelJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArtistServiceServer).CancelJob(ctx, req.(*JobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArtistService_RetryJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArtistServiceServer).RetryJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/artist.ArtistService/RetryJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArtistServiceServer).RetryJob(ctx, req.(*JobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArtistService_BulkAddArtists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkAddArtistsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArtistServiceServer).BulkAddArtists(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/artist.ArtistService/BulkAddArtists",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArtistServiceServer).BulkAddArtists(ctx, req.(*BulkAddArtistsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArtistService_Search_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArtistServiceServer).Search(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/artist.ArtistService/Search",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArtistServiceServer).Search(ctx, req.(*SearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArtistService_SuggestArtists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuggestArtistsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArtistServiceServer).SuggestArtists(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/artist.ArtistService/SuggestArtists",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArtistServiceServer).SuggestArtists(ctx, req.(*SuggestArtistsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArtistService_RefreshVideoStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshVideoStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArtistServiceServer).RefreshVideoStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/artist.ArtistService/RefreshVideoStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArtistServiceServer).RefreshVideoStats(ctx, req.(*RefreshVideoStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArtistService_GetVideoStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VideoStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArtistServiceServer).GetVideoStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/artist.ArtistService/GetVideoStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArtistServiceServer).GetVideoStats(ctx, req.(*VideoStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArtistService_SetSyncSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSyncSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArtistServiceServer).SetSyncSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/artist.ArtistService/SetSyncSettings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArtistServiceServer).SetSyncSettings(ctx, req.(*SetSyncSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArtistService_ListSyncRuns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSyncRunsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArtistServiceServer).ListSyncRuns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/artist.ArtistService/ListSyncRuns",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArtistServiceServer).ListSyncRuns(ctx, req.(*ListSyncRunsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArtistService_GetSyncRun_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SyncRunRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArtistServiceServer).GetSyncRun(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/artist.ArtistService/GetSyncRun",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArtistServiceServer).GetSyncRun(ctx, req.(*SyncRunRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArtistService_GetSyncSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SyncScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArtistServiceServer).GetSyncSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/artist.ArtistService/GetSyncSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArtistServiceServer).GetSyncSchedule(ctx, req.(*SyncScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArtistService_CreateDownloadRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DownloadRule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArtistServiceServer).CreateDownloadRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/artist.ArtistService/CreateDownloadRule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArtistServiceServer).CreateDownloadRule(ctx, req.(*DownloadRule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArtistService_UpdateDownloadRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DownloadRule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArtistServiceServer).UpdateDownloadRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/artist.ArtistService/UpdateDownloadRule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArtistServiceServer).UpdateDownloadRule(ctx, req.(*DownloadRule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArtistService_DeleteDownloadRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DownloadRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArtistServiceServer).DeleteDownloadRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/artist.ArtistService/DeleteDownloadRule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArtistServiceServer).DeleteDownloadRule(ctx, req.(*DownloadRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArtistService_ListDownloadRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDownloadRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArtistServiceServer).ListDownloadRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/artist.ArtistService/ListDownloadRules",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArtistServiceServer).ListDownloadRules(ctx, req.(*ListDownloadRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArtistService_PreviewDownloadRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewDownloadRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArtistServiceServer).PreviewDownloadRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/artist.ArtistService/PreviewDownloadRules",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArtistServiceServer).PreviewDownloadRules(ctx, req.(*PreviewDownloadRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ArtistService_ServiceDesc is the grpc.ServiceDesc for ArtistService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ArtistService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "artist.ArtistService",
+	HandlerType: (*ArtistServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SyncArtist",
+			Handler:    _ArtistService_SyncArtist_Handler,
+		},
+		{
+			MethodName: "ReadArtistAlbums",
+			Handler:    _ArtistService_ReadArtistAlbums_Handler,
+		},
+		{
+			MethodName: "ReadAlbumTracks",
+			Handler:    _ArtistService_ReadAlbumTracks_Handler,
+		},
+		{
+			MethodName: "DeleteArtist",
+			Handler:    _ArtistService_DeleteArtist_Handler,
+		},
+		{
+			MethodName: "SetPlanned",
+			Handler:    _ArtistService_SetPlanned_Handler,
 		},
 		{
 			MethodName: "ClearSync",
 			Handler:    _ArtistService_ClearSync_Handler,
 		},
+		{
+			MethodName: "AcknowledgeItems",
+			Handler:    _ArtistService_AcknowledgeItems_Handler,
+		},
+		{
+			MethodName: "AcknowledgeArtist",
+			Handler:    _ArtistService_AcknowledgeArtist_Handler,
+		},
+		{
+			MethodName: "AcknowledgeOlderThan",
+			Handler:    _ArtistService_AcknowledgeOlderThan_Handler,
+		},
 		{
 			MethodName: "DownloadAlbums",
 			Handler:    _ArtistService_DownloadAlbums_Handler,
@@ -352,6 +1220,287 @@ var ArtistService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListArtist",
 			Handler:    _ArtistService_ListArtist_Handler,
 		},
+		{
+			MethodName: "SubmitJob",
+			Handler:    _ArtistService_SubmitJob_Handler,
+		},
+		{
+			MethodName: "GetJob",
+			Handler:    _ArtistService_GetJob_Handler,
+		},
+		{
+			MethodName: "ListJobs",
+			Handler:    _ArtistService_ListJobs_Handler,
+		},
+		{
+			MethodName: "CancelJob",
+			Handler:    _ArtistService_CancelJob_Handler,
+		},
+		{
+			MethodName: "RetryJob",
+			Handler:    _ArtistService_RetryJob_Handler,
+		},
+		{
+			MethodName: "BulkAddArtists",
+			Handler:    _ArtistService_BulkAddArtists_Handler,
+		},
+		{
+			MethodName: "Search",
+			Handler:    _ArtistService_Search_Handler,
+		},
+		{
+			MethodName: "SuggestArtists",
+			Handler:    _ArtistService_SuggestArtists_Handler,
+		},
+		{
+			MethodName: "RefreshVideoStats",
+			Handler:    _ArtistService_RefreshVideoStats_Handler,
+		},
+		{
+			MethodName: "GetVideoStats",
+			Handler:    _ArtistService_GetVideoStats_Handler,
+		},
+		{
+			MethodName: "SetSyncSettings",
+			Handler:    _ArtistService_SetSyncSettings_Handler,
+		},
+		{
+			MethodName: "ListSyncRuns",
+			Handler:    _ArtistService_ListSyncRuns_Handler,
+		},
+		{
+			MethodName: "GetSyncRun",
+			Handler:    _ArtistService_GetSyncRun_Handler,
+		},
+		{
+			MethodName: "GetSyncSchedule",
+			Handler:    _ArtistService_GetSyncSchedule_Handler,
+		},
+		{
+			MethodName: "CreateDownloadRule",
+			Handler:    _ArtistService_CreateDownloadRule_Handler,
+		},
+		{
+			MethodName: "UpdateDownloadRule",
+			Handler:    _ArtistService_UpdateDownloadRule_Handler,
+		},
+		{
+			MethodName: "DeleteDownloadRule",
+			Handler:    _ArtistService_DeleteDownloadRule_Handler,
+		},
+		{
+			MethodName: "ListDownloadRules",
+			Handler:    _ArtistService_ListDownloadRules_Handler,
+		},
+		{
+			MethodName: "PreviewDownloadRules",
+			Handler:    _ArtistService_PreviewDownloadRules_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SyncArtistStream",
+			Handler:       _ArtistService_SyncArtistStream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "DownloadAlbumsStream",
+			Handler:       _ArtistService_DownloadAlbumsStream_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "artist.proto",
+}
+
+// AdminServiceClient is the client API for AdminService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AdminServiceClient interface {
+	ListSites(ctx context.Context, in *ListSitesRequest, opts ...grpc.CallOption) (*ListSitesResponse, error)
+	UpdateSiteCredentials(ctx context.Context, in *UpdateSiteCredentialsRequest, opts ...grpc.CallOption) (*Site, error)
+	ValidateSiteCredentials(ctx context.Context, in *ValidateSiteCredentialsRequest, opts ...grpc.CallOption) (*ValidateSiteCredentialsResponse, error)
+	GetQuota(ctx context.Context, in *QuotaRequest, opts ...grpc.CallOption) (*QuotaResponse, error)
+}
+
+type adminServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAdminServiceClient(cc grpc.ClientConnInterface) AdminServiceClient {
+	return &adminServiceClient{cc}
+}
+
+func (c *adminServiceClient) ListSites(ctx context.Context, in *ListSitesRequest, opts ...grpc.CallOption) (*ListSitesResponse, error) {
+	out := new(ListSitesResponse)
+	err := c.cc.Invoke(ctx, "/artist.AdminService/ListSites", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) UpdateSiteCredentials(ctx context.Context, in *UpdateSiteCredentialsRequest, opts ...grpc.CallOption) (*Site, error) {
+	out := new(Site)
+	err := c.cc.Invoke(ctx, "/artist.AdminService/UpdateSiteCredentials", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ValidateSiteCredentials(ctx context.Context, in *ValidateSiteCredentialsRequest, opts ...grpc.CallOption) (*ValidateSiteCredentialsResponse, error) {
+	out := new(ValidateSiteCredentialsResponse)
+	err := c.cc.Invoke(ctx, "/artist.AdminService/ValidateSiteCredentials", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetQuota(ctx context.Context, in *QuotaRequest, opts ...grpc.CallOption) (*QuotaResponse, error) {
+	out := new(QuotaResponse)
+	err := c.cc.Invoke(ctx, "/artist.AdminService/GetQuota", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AdminServiceServer is the server API for AdminService service.
+// All implementations must embed UnimplementedAdminServiceServer
+// for forward compatibility
+type AdminServiceServer interface {
+	ListSites(context.Context, *ListSitesRequest) (*ListSitesResponse, error)
+	UpdateSiteCredentials(context.Context, *UpdateSiteCredentialsRequest) (*Site, error)
+	ValidateSiteCredentials(context.Context, *ValidateSiteCredentialsRequest) (*ValidateSiteCredentialsResponse, error)
+	GetQuota(context.Context, *QuotaRequest) (*QuotaResponse, error)
+	mustEmbedUnimplementedAdminServiceServer()
+}
+
+// UnimplementedAdminServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedAdminServiceServer struct {
+}
+
+func (UnimplementedAdminServiceServer) ListSites(context.Context, *ListSitesRequest) (*ListSitesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSites not implemented")
+}
+func (UnimplementedAdminServiceServer) UpdateSiteCredentials(context.Context, *UpdateSiteCredentialsRequest) (*Site, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSiteCredentials not implemented")
+}
+func (UnimplementedAdminServiceServer) ValidateSiteCredentials(context.Context, *ValidateSiteCredentialsRequest) (*ValidateSiteCredentialsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateSiteCredentials not implemented")
+}
+func (UnimplementedAdminServiceServer) GetQuota(context.Context, *QuotaRequest) (*QuotaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetQuota not implemented")
+}
+func (UnimplementedAdminServiceServer) mustEmbedUnimplementedAdminServiceServer() {}
+
+// UnsafeAdminServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AdminServiceServer will
+// result in compilation errors.
+type UnsafeAdminServiceServer interface {
+	mustEmbedUnimplementedAdminServiceServer()
+}
+
+func RegisterAdminServiceServer(s grpc.ServiceRegistrar, srv AdminServiceServer) {
+	s.RegisterService(&AdminService_ServiceDesc, srv)
+}
+
+func _AdminService_ListSites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSitesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListSites(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/artist.AdminService/ListSites",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListSites(ctx, req.(*ListSitesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_UpdateSiteCredentials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSiteCredentialsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UpdateSiteCredentials(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/artist.AdminService/UpdateSiteCredentials",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UpdateSiteCredentials(ctx, req.(*UpdateSiteCredentialsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ValidateSiteCredentials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateSiteCredentialsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ValidateSiteCredentials(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/artist.AdminService/ValidateSiteCredentials",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ValidateSiteCredentials(ctx, req.(*ValidateSiteCredentialsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/artist.AdminService/GetQuota",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetQuota(ctx, req.(*QuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AdminService_ServiceDesc is the grpc.ServiceDesc for AdminService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AdminService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "artist.AdminService",
+	HandlerType: (*AdminServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListSites",
+			Handler:    _AdminService_ListSites_Handler,
+		},
+		{
+			MethodName: "UpdateSiteCredentials",
+			Handler:    _AdminService_UpdateSiteCredentials_Handler,
+		},
+		{
+			MethodName: "ValidateSiteCredentials",
+			Handler:    _AdminService_ValidateSiteCredentials_Handler,
+		},
+		{
+			MethodName: "GetQuota",
+			Handler:    _AdminService_GetQuota_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "artist.proto",
@@ -6,7 +6,6 @@ import (
 	"fmt"
 	"io"
 	"log"
-	"math/rand"
 	"os"
 	"path/filepath"
 	"strings"
@@ -45,33 +44,44 @@ func main() {
 	if err != nil {
 		log.Println(err)
 	}
+	var ids []string
 	rd := bufio.NewReader(idsFile)
 	for {
 		line, er := rd.ReadString('\n')
+		if line = strings.TrimSpace(line); line != "" {
+			ids = append(ids, line)
+		}
 		if er != nil {
 			if er == io.EOF {
 				break
 			}
 			log.Fatalf("read file line error: %v", er)
 		}
+	}
 
-		id := strings.Trim(strings.TrimSpace(line), "\"")
-		fmt.Printf("started %v \n", id)
-		fmt.Println(id)
+	// сервер сам добавит всех с паузами, нам остается только следить за задачей
+	job, err := c.BulkAddArtists(context.Background(), &artist.BulkAddArtistsRequest{
+		SiteId: 1,
+		Ids:    ids,
+	})
+	if err != nil {
+		log.Fatalf("error happened: %v", err)
+	}
+	fmt.Printf("job %v started, ids: %v \n", job.GetId(), len(ids))
 
-		req := &artist.SyncArtistRequest{
-			SiteId:   1,
-			ArtistId: id,
-			IsAdd:    true,
+	for job.GetState() == 0 || job.GetState() == 1 {
+		time.Sleep(10 * time.Second)
+		job, err = c.GetJob(context.Background(), &artist.JobRequest{JobId: job.GetId()})
+		if err != nil {
+			log.Fatalf("error happened: %v", err)
 		}
-		_, er = c.SyncArtist(context.Background(), req)
-		if er != nil {
-			fmt.Printf("error happened: %v \n", er)
-		}
-		fmt.Printf("added: %v \n", req.ArtistId)
+		fmt.Printf("processed: %v/%v \n", job.GetProgress(), job.GetTotal())
+	}
 
-		sec := time.Duration(10+rand.Intn(10)) * time.Second
-		fmt.Printf("sleep %v sec... \n", sec.Seconds())
-		time.Sleep(sec)
+	for id, res := range job.GetResult() {
+		fmt.Printf("%v: %v \n", id, res)
+	}
+	if job.GetError() != "" {
+		fmt.Printf("error happened: %v \n", job.GetError())
 	}
 }
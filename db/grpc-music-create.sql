@@ -13,6 +13,11 @@ CREATE TABLE artist (
     userAdded INTEGER default 0,
     syncState INTEGER default 1,
     thumbnail BLOB,
+    lastFullSync TEXT,
+    skipSync INTEGER DEFAULT 0 NOT NULL,
+    syncInterval INTEGER DEFAULT 0 NOT NULL,
+    priority INTEGER DEFAULT 0 NOT NULL,
+    lastSync TEXT,
     UNIQUE(siteId,artistId)
 );
 CREATE TABLE album (
@@ -24,13 +29,29 @@ CREATE TABLE album (
     trackTotal INTEGER default 0,
     syncState INTEGER default 0,
     thumbnail BLOB,
+    removedAt TEXT,
     UNIQUE(albumId,title)
 );
 CREATE TABLE artistAlbum (
     artistId INTEGER REFERENCES artist (art_id) ON DELETE CASCADE,
     albumId INTEGER REFERENCES album (alb_id) ON DELETE CASCADE,
+    listed INTEGER default 0,
     UNIQUE(artistId,albumId)
 );
+CREATE TABLE track (
+    tr_id INTEGER PRIMARY KEY AUTOINCREMENT,
+    albumId INTEGER REFERENCES album (alb_id) ON DELETE CASCADE,
+    trackId TEXT NOT NULL,
+    position INTEGER default 0,
+    title TEXT,
+    duration INTEGER default 0,
+    genres TEXT,
+    hasFlac INTEGER default 0,
+    highestQuality TEXT,
+    explicit INTEGER default 0,
+    artistNames TEXT,
+    UNIQUE(albumId,trackId)
+);
 CREATE TABLE channel (
     ch_id INTEGER PRIMARY KEY AUTOINCREMENT,
     siteId INTEGER REFERENCES site (site_id) ON UPDATE CASCADE ON DELETE RESTRICT,
@@ -39,6 +60,10 @@ CREATE TABLE channel (
     syncState INTEGER DEFAULT 1 NOT NULL,
     thumbnail BLOB,
     skipSync INTEGER DEFAULT 0 NOT NULL,
+    lastFullSync TEXT,
+    syncInterval INTEGER DEFAULT 0 NOT NULL,
+    priority INTEGER DEFAULT 0 NOT NULL,
+    lastSync TEXT,
     UNIQUE(siteId,channelId)
 );
 CREATE TABLE playlist (
@@ -66,15 +91,91 @@ CREATE TABLE video (
     syncState INTEGER DEFAULT 0 NOT NULL,
     listState INTEGER DEFAULT 0 NOT NULL,
     watchState INTEGER DEFAULT 0 NOT NULL,
+    kind INTEGER DEFAULT 0 NOT NULL,
     thumbnail BLOB,
     quality REAL GENERATED ALWAYS AS (1.0 * likeCount / viewCount * 100) VIRTUAL,
     UNIQUE(videoId,title)
 );
+CREATE TABLE videoStats (
+    videoId INTEGER REFERENCES video (vid_id) ON DELETE CASCADE,
+    taken TEXT DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    viewCount INTEGER DEFAULT 0 NOT NULL,
+    likeCount INTEGER DEFAULT 0 NOT NULL,
+    commentCount INTEGER DEFAULT 0 NOT NULL
+);
 CREATE TABLE playlistVideo (
     playlistId INTEGER REFERENCES playlist (pl_id) ON UPDATE CASCADE ON DELETE CASCADE,
     videoId INTEGER REFERENCES video (vid_id) ON UPDATE CASCADE ON DELETE CASCADE,
     UNIQUE(playlistId,videoId)
 );
+CREATE TABLE job (
+    job_id INTEGER PRIMARY KEY AUTOINCREMENT,
+    siteId INTEGER REFERENCES site (site_id) ON UPDATE CASCADE ON DELETE RESTRICT,
+    jobType INTEGER DEFAULT 0 NOT NULL,
+    state INTEGER DEFAULT 0 NOT NULL,
+    params TEXT,
+    progress INTEGER DEFAULT 0 NOT NULL,
+    total INTEGER DEFAULT 0 NOT NULL,
+    result TEXT,
+    error TEXT,
+    created TEXT DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    updated TEXT DEFAULT CURRENT_TIMESTAMP NOT NULL
+);
+
+CREATE TABLE youtubeQuota (
+    day TEXT NOT NULL,
+    method TEXT NOT NULL,
+    units INTEGER DEFAULT 0 NOT NULL,
+    calls INTEGER DEFAULT 0 NOT NULL,
+    UNIQUE(day,method)
+);
+
+CREATE TABLE syncRun (
+    run_id INTEGER PRIMARY KEY AUTOINCREMENT,
+    siteId INTEGER REFERENCES site (site_id) ON UPDATE CASCADE ON DELETE RESTRICT,
+    artistId TEXT NOT NULL,
+    triggeredBy INTEGER DEFAULT 0 NOT NULL,
+    state INTEGER DEFAULT 0 NOT NULL,
+    isAdd INTEGER DEFAULT 0 NOT NULL,
+    startedAt TEXT DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    finishedAt TEXT,
+    durationMs INTEGER DEFAULT 0 NOT NULL,
+    artistCount INTEGER DEFAULT 0 NOT NULL,
+    newAlbumCount INTEGER DEFAULT 0 NOT NULL,
+    removedAlbumCount INTEGER DEFAULT 0 NOT NULL,
+    newArtistCount INTEGER DEFAULT 0 NOT NULL,
+    failedCount INTEGER DEFAULT 0 NOT NULL,
+    error TEXT
+);
+CREATE TABLE syncRunItem (
+    runId INTEGER REFERENCES syncRun (run_id) ON DELETE CASCADE,
+    artistId TEXT NOT NULL,
+    title TEXT,
+    newAlbumCount INTEGER DEFAULT 0 NOT NULL,
+    removedAlbumCount INTEGER DEFAULT 0 NOT NULL,
+    newArtistCount INTEGER DEFAULT 0 NOT NULL,
+    error TEXT,
+    reason TEXT,
+    statusCode INTEGER DEFAULT 0 NOT NULL,
+    startedAt TEXT NOT NULL,
+    durationMs INTEGER DEFAULT 0 NOT NULL
+);
+CREATE TABLE downloadRule (
+    rule_id INTEGER PRIMARY KEY AUTOINCREMENT,
+    siteId INTEGER REFERENCES site (site_id) ON UPDATE CASCADE ON DELETE RESTRICT,
+    artistId TEXT DEFAULT '' NOT NULL,
+    releaseTypes TEXT,
+    kinds TEXT,
+    action INTEGER DEFAULT 0 NOT NULL,
+    quality TEXT,
+    enabled INTEGER DEFAULT 1 NOT NULL,
+    created TEXT DEFAULT CURRENT_TIMESTAMP NOT NULL
+);
+
+CREATE VIRTUAL TABLE search USING fts5(
+    title,
+    tokenize = 'unicode61 remove_diacritics 2'
+);
 
 CREATE TRIGGER IF NOT EXISTS delete_channel BEFORE DELETE ON channel
     BEGIN
@@ -96,4 +197,77 @@ CREATE INDEX index_video_syncState ON video(syncState);
 
 CREATE INDEX index_playlistVideo_playlistId ON playlistVideo(playlistId);
 
-CREATE INDEX index_playlistVideo_videoId ON playlistVideo(videoId);
\ No newline at end of file
+CREATE INDEX index_playlistVideo_videoId ON playlistVideo(videoId);
+
+CREATE INDEX index_job_state ON job(state);
+
+CREATE INDEX index_videoStats_videoId ON videoStats(videoId, taken);
+
+CREATE INDEX index_syncRun_started ON syncRun(startedAt);
+
+CREATE INDEX index_syncRunItem_runId ON syncRunItem(runId);
+
+CREATE INDEX index_syncRunItem_artistId ON syncRunItem(artistId, runId);
+
+CREATE INDEX index_downloadRule_site ON downloadRule(siteId, artistId);
+
+-- rowid поиска = id * 4 + вид строки: 0 artist, 1 album, 2 channel, 3 video
+CREATE TRIGGER IF NOT EXISTS search_artist_insert AFTER INSERT ON artist
+    BEGIN
+        INSERT INTO search(rowid, title) VALUES (new.art_id * 4, new.title);
+    END;
+
+CREATE TRIGGER IF NOT EXISTS search_artist_update AFTER UPDATE OF title ON artist
+    BEGIN
+        UPDATE search SET title = new.title WHERE rowid = old.art_id * 4;
+    END;
+
+CREATE TRIGGER IF NOT EXISTS search_artist_delete AFTER DELETE ON artist
+    BEGIN
+        DELETE FROM search WHERE rowid = old.art_id * 4;
+    END;
+
+CREATE TRIGGER IF NOT EXISTS search_album_insert AFTER INSERT ON album
+    BEGIN
+        INSERT INTO search(rowid, title) VALUES (new.alb_id * 4 + 1, new.title);
+    END;
+
+CREATE TRIGGER IF NOT EXISTS search_album_update AFTER UPDATE OF title ON album
+    BEGIN
+        UPDATE search SET title = new.title WHERE rowid = old.alb_id * 4 + 1;
+    END;
+
+CREATE TRIGGER IF NOT EXISTS search_album_delete AFTER DELETE ON album
+    BEGIN
+        DELETE FROM search WHERE rowid = old.alb_id * 4 + 1;
+    END;
+
+CREATE TRIGGER IF NOT EXISTS search_channel_insert AFTER INSERT ON channel
+    BEGIN
+        INSERT INTO search(rowid, title) VALUES (new.ch_id * 4 + 2, new.title);
+    END;
+
+CREATE TRIGGER IF NOT EXISTS search_channel_update AFTER UPDATE OF title ON channel
+    BEGIN
+        UPDATE search SET title = new.title WHERE rowid = old.ch_id * 4 + 2;
+    END;
+
+CREATE TRIGGER IF NOT EXISTS search_channel_delete AFTER DELETE ON channel
+    BEGIN
+        DELETE FROM search WHERE rowid = old.ch_id * 4 + 2;
+    END;
+
+CREATE TRIGGER IF NOT EXISTS search_video_insert AFTER INSERT ON video
+    BEGIN
+        INSERT INTO search(rowid, title) VALUES (new.vid_id * 4 + 3, new.title);
+    END;
+
+CREATE TRIGGER IF NOT EXISTS search_video_update AFTER UPDATE OF title ON video
+    BEGIN
+        UPDATE search SET title = new.title WHERE rowid = old.vid_id * 4 + 3;
+    END;
+
+CREATE TRIGGER IF NOT EXISTS search_video_delete AFTER DELETE ON video
+    BEGIN
+        DELETE FROM search WHERE rowid = old.vid_id * 4 + 3;
+    END;
\ No newline at end of file
@@ -377,6 +377,8 @@ func MapAlbum(alb *artist.Album, serial int, isRead bool) model.Message {
 		SentAt:   at,
 		Avatar:   im,
 		Read:     isRead,
+		Removed:  alb.GetRemovedAt() != "",
+		Kind:     alb.GetKind(),
 	}
 }
 
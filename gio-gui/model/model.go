@@ -15,23 +15,42 @@ import (
 type Message struct {
 	SerialID                      string
 	TypeId, Views, Likes, State   int32
+	Kind                          int32
 	Quality                       float32
 	Title, Content, AlbumId, Type string
 	ParentId                      []string
 	SentAt                        time.Time
 	Avatar                        image.Image
-	Read                          bool
+	Read, Removed                 bool
 }
 
 func (m Message) GetStringType() string {
+	var res string
 	switch m.TypeId {
 	case 0:
-		return "Album"
+		res = "Album"
 	case 1:
-		return "Single"
-	default:
-		return ""
+		res = "Single"
+	case 3:
+		// видео ютуба различаем по виду
+		switch m.Kind {
+		case 1:
+			res = "Short"
+		case 2:
+			res = "Live"
+		case 3:
+			res = "Premiere"
+		default:
+			res = "Video"
+		}
+	case 4:
+		res = "Compilation"
 	}
+	if m.Removed {
+		// релиз пропал с сайта, скачать его уже не выйдет
+		res += " (removed)"
+	}
+	return res
 }
 
 // Serial returns the unique identifier for this message.
@@ -57,7 +57,7 @@ type RowConfig struct {
 func NewRow(th *material.Theme, interact *Row, menu *component.MenuState, msg *RowConfig) RowStyle {
 	interact.Avatar.Cache(msg.Avatar)
 	var year string
-	if msg.TypeId <= 2 {
+	if msg.TypeId <= 2 || msg.TypeId == 4 {
 		year = strconv.Itoa(msg.SentAt.Local().Year())
 	}
 
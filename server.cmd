@@ -1 +1 @@
-go run .\server .
\ No newline at end of file
+go run -tags sqlite_fts5 .\server .
\ No newline at end of file
@@ -0,0 +1,227 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/v0vc/go-music-grpc/artist"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type adminServer struct {
+	artist.AdminServiceServer
+}
+
+// maskToken оставляет видимыми только края токена, чтобы его можно было узнать, но не украсть
+func maskToken(token string) string {
+	if len(token) <= 12 {
+		return strings.Repeat("*", len(token))
+	}
+	return token[:4] + strings.Repeat("*", len(token)-8) + token[len(token)-4:]
+}
+
+func scanSite(row interface{ Scan(dest ...any) error }) (*artist.Site, error) {
+	var (
+		site               artist.Site
+		login, pass, token sql.NullString
+	)
+
+	if err := row.Scan(&site.SiteId, &site.Title, &login, &pass, &token); err != nil {
+		return nil, err
+	}
+	site.Login = login.String
+	site.HasPass = pass.String != ""
+	site.Token = maskToken(token.String)
+	return &site, nil
+}
+
+func ListSitesDb(ctx context.Context) ([]*artist.Site, error) {
+	db, err := sql.Open(sqlite3, fmt.Sprintf("file:%v?cache=shared&mode=ro", dbFile))
+	if err != nil {
+		log.Println(err)
+	}
+	defer func(db *sql.DB) {
+		err = db.Close()
+		if err != nil {
+			log.Println(err)
+		}
+	}(db)
+
+	rows, err := db.QueryContext(ctx, "select site_id, title, login, pass, token from main.site order by site_id;")
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	defer func(rows *sql.Rows) {
+		err = rows.Close()
+		if err != nil {
+			log.Println(err)
+		}
+	}(rows)
+
+	var sites []*artist.Site
+
+	for rows.Next() {
+		site, er := scanSite(rows)
+		if er != nil {
+			log.Println(er)
+		} else {
+			sites = append(sites, site)
+		}
+	}
+
+	return sites, rows.Err()
+}
+
+func GetSiteDb(ctx context.Context, siteId uint32) (*artist.Site, error) {
+	db, err := sql.Open(sqlite3, fmt.Sprintf("file:%v?cache=shared&mode=ro", dbFile))
+	if err != nil {
+		log.Println(err)
+	}
+	defer func(db *sql.DB) {
+		err = db.Close()
+		if err != nil {
+			log.Println(err)
+		}
+	}(db)
+
+	return scanSite(db.QueryRowContext(ctx, "select site_id, title, login, pass, token from main.site where site_id = ? limit 1;", siteId))
+}
+
+// UpdateSiteCredentialsDb changes only the fields set in the request
+func UpdateSiteCredentialsDb(ctx context.Context, req *artist.UpdateSiteCredentialsRequest) (int64, error) {
+	txMu.Lock()
+	defer txMu.Unlock()
+
+	db, err := sql.Open(sqlite3, fmt.Sprintf("file:%v?_foreign_keys=false&cache=shared&mode=rw", dbFile))
+	if err != nil {
+		log.Println(err)
+	}
+	defer func(db *sql.DB) {
+		err = db.Close()
+		if err != nil {
+			log.Println(err)
+		}
+	}(db)
+
+	var (
+		sets []string
+		args []interface{}
+	)
+
+	if req.Login != nil {
+		sets = append(sets, "login = ?")
+		args = append(args, req.GetLogin())
+	}
+	if req.Pass != nil {
+		sets = append(sets, "pass = ?")
+		args = append(args, req.GetPass())
+	}
+	if req.Token != nil {
+		sets = append(sets, "token = ?")
+		args = append(args, req.GetToken())
+	}
+	args = append(args, req.GetSiteId())
+
+	res, err := db.ExecContext(ctx, fmt.Sprintf("update main.site set %s where site_id = ?;", strings.Join(sets, ", ")), args...)
+	if err != nil {
+		log.Println(err)
+		return 0, err
+	}
+
+	return res.RowsAffected()
+}
+
+func (*adminServer) ListSites(ctx context.Context, _ *artist.ListSitesRequest) (*artist.ListSitesResponse, error) {
+	sites, err := ListSitesDb(ctx)
+	if err != nil {
+		log.Printf("List sites error: %v", err)
+		return nil, toStatus(err)
+	}
+
+	return &artist.ListSitesResponse{Sites: sites}, nil
+}
+
+func (*adminServer) UpdateSiteCredentials(ctx context.Context, req *artist.UpdateSiteCredentialsRequest) (*artist.Site, error) {
+	siteId := req.GetSiteId()
+	if req.Login == nil && req.Pass == nil && req.Token == nil {
+		return nil, status.Error(codes.InvalidArgument, "nothing to update")
+	}
+	fmt.Printf("siteId: %v, update credentials started\n", siteId)
+
+	aff, err := UpdateSiteCredentialsDb(context.WithoutCancel(ctx), req)
+	if err != nil {
+		log.Printf("Update credentials error: %v", err)
+		return nil, toStatus(err)
+	}
+	if aff == 0 {
+		return nil, status.Errorf(codes.NotFound, "site %v not found", siteId)
+	}
+	fmt.Printf("siteId: %v, update credentials completed\n", siteId)
+
+	site, err := GetSiteDb(ctx, siteId)
+	if err != nil {
+		log.Printf("Read site error: %v", err)
+		return nil, toStatus(err)
+	}
+
+	return site, nil
+}
+
+func (*adminServer) ValidateSiteCredentials(ctx context.Context, req *artist.ValidateSiteCredentialsRequest) (*artist.ValidateSiteCredentialsResponse, error) {
+	siteId := req.GetSiteId()
+	fmt.Printf("siteId: %v, validate credentials started\n", siteId)
+
+	token := req.GetToken()
+	if req.Token == nil {
+		token = GetTokenOnlyDbWoTx(ctx, siteId)
+	}
+	if token == "" {
+		return &artist.ValidateSiteCredentialsResponse{
+			Reason:  errorReasons[ErrUnauthenticated],
+			Message: "no token",
+		}, nil
+	}
+
+	p, err := getProvider(siteId)
+	if err != nil {
+		return nil, err
+	}
+
+	err = p.ValidateToken(withoutRenew(ctx), token)
+
+	var pe *ProviderError
+	switch {
+	case err == nil:
+		fmt.Printf("siteId: %v, validate credentials completed, token is valid\n", siteId)
+		return &artist.ValidateSiteCredentialsResponse{Valid: true}, nil
+	case errors.As(err, &pe):
+		fmt.Printf("siteId: %v, validate credentials completed, %v\n", siteId, err)
+		return &artist.ValidateSiteCredentialsResponse{
+			Reason:  errorReasons[pe.Kind],
+			Message: err.Error(),
+		}, nil
+	default:
+		log.Printf("Validate credentials error: %v", err)
+		return nil, toStatus(err)
+	}
+}
+
+func (*adminServer) GetQuota(ctx context.Context, req *artist.QuotaRequest) (*artist.QuotaResponse, error) {
+	siteId := req.GetSiteId()
+	if siteId != siteYoutube {
+		return nil, status.Errorf(codes.Unimplemented, "site %v has no quota", siteId)
+	}
+
+	res, err := GetQuota(ctx, int(req.GetDays()))
+	if err != nil {
+		log.Printf("Get quota error: %v", err)
+		return nil, toStatus(err)
+	}
+	return res, nil
+}
@@ -0,0 +1,103 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/v0vc/go-music-grpc/artist"
+)
+
+const (
+	bulkAdded   = "added"
+	bulkExisted = "existed"
+	bulkInvalid = "invalid"
+	bulkFailed  = "failed: "
+)
+
+// bulkPause is the pause between two adds, tests make it shorter
+var bulkPause = func() {
+	RandomPause(10, 10)
+}
+
+func addArtist(ctx context.Context, siteId uint32, id string) error {
+	_, err := syncArtists(ctx, &artist.SyncArtistRequest{
+		SiteId:   siteId,
+		ArtistId: id,
+		IsAdd:    true,
+	}, syncTriggerBulk, func(*artist.SyncArtistEvent) {})
+	return err
+}
+
+// bulkAddArtists adds artists one by one with a random pause between the api calls, so we don't get banned.
+// The result has an entry for every id: added, existed, invalid or failed with the reason.
+func bulkAddArtists(ctx context.Context, jobId int64, siteId uint32, ids []string) (map[string]string, error) {
+	p, err := getProvider(siteId)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]string, len(ids))
+	total := int32(len(ids))
+	paced := false
+
+	for i, raw := range ids {
+		if ctx.Err() != nil {
+			return result, ctx.Err()
+		}
+		setJobProgressDb(ctx, jobId, int32(i), total)
+
+		raw = strings.TrimSpace(raw)
+		if _, ok := result[raw]; ok || raw == "" {
+			continue
+		}
+
+		id, ok := p.ResolveId(raw)
+		if !ok {
+			result[raw] = bulkInvalid
+			continue
+		}
+		if p.ArtistExists(ctx, id) {
+			result[raw] = bulkExisted
+			continue
+		}
+
+		if paced {
+			bulkPause()
+			if ctx.Err() != nil {
+				return result, ctx.Err()
+			}
+		}
+		paced = true
+
+		fmt.Printf("siteId: %v, bulk add %v/%v: %v\n", siteId, i+1, total, id)
+		err := addArtist(ctx, siteId, id)
+
+		var pe *ProviderError
+		if errors.As(err, &pe) && pe.Kind == ErrThrottled {
+			fmt.Printf("siteId: %v, bulk add throttled, wait %v\n", siteId, pe.RetryAfter)
+			select {
+			case <-ctx.Done():
+				return result, ctx.Err()
+			case <-time.After(pe.RetryAfter):
+			}
+			err = addArtist(ctx, siteId, id)
+		}
+
+		switch {
+		case err == nil:
+			result[raw] = bulkAdded
+		case errors.As(err, &pe) && (pe.Kind == ErrQuotaExceeded || pe.Kind == ErrUnauthenticated):
+			// дальше будет то же самое, остальные добавим повтором задачи
+			result[raw] = bulkFailed + err.Error()
+			return result, err
+		default:
+			result[raw] = bulkFailed + err.Error()
+		}
+	}
+
+	setJobProgressDb(ctx, jobId, total, total)
+	return result, nil
+}
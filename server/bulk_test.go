@@ -0,0 +1,51 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestBulkAddArtists(t *testing.T) {
+	newTestDb(t)
+	pause := bulkPause
+	bulkPause = func() {}
+	t.Cleanup(func() {
+		bulkPause = pause
+	})
+
+	f := newFakeProvider(t, fakeArtist("art1"), fakeArtist("art2"), fakeArtist("art3"), fakeArtist("art4"), fakeArtist("art5"), fakeArtist("art6"))
+	f.added["art2"] = true
+	f.failWith("art3", &ProviderError{Kind: ErrThrottled, Provider: "fake", RetryAfter: time.Millisecond}, nil)
+	f.failWith("art4", &ProviderError{Kind: ErrNotFound, Provider: "fake", StatusCode: http.StatusNotFound})
+	f.failWith("art5", &ProviderError{Kind: ErrQuotaExceeded, Provider: "fake"})
+
+	result, err := bulkAddArtists(context.Background(), 0, siteFake, []string{"art1", " art1 ", "bad", "art2", "", "art3", "art4", "art5", "art6"})
+	var pe *ProviderError
+	if !errors.As(err, &pe) || pe.Kind != ErrQuotaExceeded {
+		t.Fatalf("quota must stop the job, got %v", err)
+	}
+
+	want := map[string]string{
+		"art1": bulkAdded,
+		"bad":  bulkInvalid,
+		"art2": bulkExisted,
+		"art3": bulkAdded,
+		"art4": bulkFailed,
+		"art5": bulkFailed,
+	}
+	for id, res := range want {
+		if !strings.HasPrefix(result[id], res) {
+			t.Errorf("%v: got %q, want %q", id, result[id], res)
+		}
+	}
+	if _, ok := result["art6"]; ok {
+		t.Error("art6 goes after the quota error and must be left for the retry")
+	}
+	if len(result) != len(want) {
+		t.Errorf("got %v results, want %v: %v", len(result), len(want), result)
+	}
+}
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseCron(t *testing.T) {
+	for _, spec := range []string{"* * * * *", "0 */6 * * *", "5/15 1-5 1,15 * 0", "30 3 * * 7", "0 0 1-31/2 1-12 1-5"} {
+		if _, err := parseCron(spec); err != nil {
+			t.Errorf("parseCron(%q): %v", spec, err)
+		}
+	}
+	for _, spec := range []string{"", "* * * *", "60 * * * *", "* 24 * * *", "* * 0 * *", "* * * 13 *", "* * * * 8", "*/0 * * * *", "5-1 * * * *", "a * * * *"} {
+		if _, err := parseCron(spec); err == nil {
+			t.Errorf("parseCron(%q) wants an error", spec)
+		}
+	}
+
+	c, err := parseCron("0 0 * * 7")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.dow&1 == 0 {
+		t.Error("7 must be sunday like 0")
+	}
+}
+
+func TestCronNext(t *testing.T) {
+	at := func(s string) time.Time {
+		res, err := time.ParseInLocation(time.DateTime, s, time.UTC)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return res
+	}
+	tests := []struct {
+		spec, from, want string
+	}{
+		{"* * * * *", "2026-05-01 10:00:30", "2026-05-01 10:01:00"},
+		{"0 */6 * * *", "2026-05-01 10:00:00", "2026-05-01 12:00:00"},
+		{"0 */6 * * *", "2026-05-01 18:00:00", "2026-05-02 00:00:00"},
+		{"30 3 * * 1", "2026-05-01 10:00:00", "2026-05-04 03:30:00"},
+		{"0 0 29 2 *", "2026-03-01 00:00:00", "2028-02-29 00:00:00"},
+		// день месяца или день недели, как в cron
+		{"0 0 15 * 1", "2026-05-01 10:00:00", "2026-05-04 00:00:00"},
+		{"0 0 31 12 *", "2026-12-31 00:00:00", "2027-12-31 00:00:00"},
+	}
+	for _, tt := range tests {
+		c, err := parseCron(tt.spec)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := c.next(at(tt.from)); !got.Equal(at(tt.want)) {
+			t.Errorf("%q after %v = %v, want %v", tt.spec, tt.from, got.Format(time.DateTime), tt.want)
+		}
+	}
+
+	never, err := parseCron("0 0 31 2 *")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := never.next(at("2026-05-01 10:00:00")); !got.IsZero() {
+		t.Errorf("31 february = %v, want zero time", got)
+	}
+}
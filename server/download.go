@@ -0,0 +1,53 @@
+package main
+
+import (
+	"context"
+
+	"github.com/v0vc/go-music-grpc/artist"
+)
+
+const (
+	downloadEventQueued int32 = iota
+	downloadEventStarted
+	downloadEventProgress
+	downloadEventTagged
+	downloadEventSkipped
+	downloadEventFailed
+	downloadEventFinished
+)
+
+// progressInterval is the minimal pause in ms between two byte progress reports
+const progressInterval = 500
+
+// DownloadProgress receives per-track download events, nil means the old console output only
+type DownloadProgress func(event *artist.DownloadEvent)
+
+func (p DownloadProgress) emit(event *artist.DownloadEvent) {
+	if p != nil {
+		p(event)
+	}
+}
+
+func downloadAlbums(ctx context.Context, siteId uint32, albIds []string, trackQuality string, isPl bool, progress DownloadProgress) (map[string]string, error) {
+	p, err := getProvider(siteId)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.Download(ctx, albIds, trackQuality, isPl, progress)
+}
+
+// downloadArtist downloads every release of the artist the same way as downloadAlbums
+func downloadArtist(ctx context.Context, siteId uint32, artistId string, trackQuality string, progress DownloadProgress) (map[string]string, error) {
+	p, err := getProvider(siteId)
+	if err != nil {
+		return nil, err
+	}
+
+	albIds, err := p.ReleaseIds(ctx, artistId)
+	if err != nil {
+		return nil, err
+	}
+
+	return downloadAlbums(ctx, siteId, albIds, trackQuality, false, progress)
+}
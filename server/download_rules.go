@@ -0,0 +1,383 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+
+	"github.com/v0vc/go-music-grpc/artist"
+)
+
+const (
+	ruleActionDownload int32 = iota
+	ruleActionIgnore
+)
+
+var (
+	// releaseTypes are the types a rule may name, 2 is taken by playlists in the ui
+	ruleReleaseTypes = []int32{0, 1, 3, 4}
+	zvukQualities    = []string{"mid", "high", "flac"}
+	// youQualities are the yt-dlp formats the client passes to DownloadVideos
+	youQualities = []string{"best", "bestvideo+bestaudio", "bestaudio"}
+)
+
+// pendingReleaseQueries returns artist id and release of every new release, the input of the dry run
+var pendingReleaseQueries = map[uint32]string{
+	siteZvuk:    "select ar.artistId, a.albumId, a.title, a.releaseDate, a.releaseType, 0 from main.artistAlbum aa join main.album a on a.alb_id = aa.albumId join main.artist ar on ar.art_id = aa.artistId where a.syncState = 1 and ar.userAdded = 1 and ar.siteId = ?",
+	siteYoutube: "select c.channelId, v.videoId, v.title, v.timestamp, 3, v.kind from main.video v join main.playlistVideo pV on v.vid_id = pV.videoId join main.channelPlaylist cP on cP.playlistId = pV.playlistId join main.channel c on c.ch_id = cP.channelId where v.syncState = 1 and c.siteId = ?",
+}
+
+func validateDownloadRule(ctx context.Context, p Provider, rule *artist.DownloadRule) error {
+	if rule.GetArtistId() != "" && !p.ArtistExists(ctx, rule.GetArtistId()) {
+		return fmt.Errorf("no artist: %v", rule.GetArtistId())
+	}
+	for _, t := range rule.GetReleaseTypes() {
+		if !slices.Contains(ruleReleaseTypes, t) {
+			return fmt.Errorf("bad release type: %v", t)
+		}
+	}
+	for _, kind := range rule.GetKinds() {
+		if rule.GetSiteId() != siteYoutube || kind < videoKindRegular || kind > videoKindPremiere {
+			return fmt.Errorf("bad video kind: %v", kind)
+		}
+	}
+
+	switch rule.GetAction() {
+	case ruleActionDownload:
+		if rule.GetQuality() == "" {
+			return errors.New("quality is required")
+		}
+		qualities := zvukQualities
+		if rule.GetSiteId() == siteYoutube {
+			qualities = youQualities
+		}
+		if !slices.Contains(qualities, rule.GetQuality()) {
+			return fmt.Errorf("bad quality: %v, want one of %v", rule.GetQuality(), strings.Join(qualities, ", "))
+		}
+	case ruleActionIgnore:
+	default:
+		return fmt.Errorf("bad action: %v", rule.GetAction())
+	}
+	return nil
+}
+
+// matchRule picks the rule for a new release: rules of the artist go before the rules of the site, then the older first
+func matchRule(rules []*artist.DownloadRule, artistId string, alb *artist.Album) *artist.DownloadRule {
+	for _, own := range []bool{true, false} {
+		for _, rule := range rules {
+			if (rule.GetArtistId() == artistId) != own || (!own && rule.GetArtistId() != "") {
+				continue
+			}
+			if len(rule.GetReleaseTypes()) > 0 && !slices.Contains(rule.GetReleaseTypes(), alb.GetReleaseType()) {
+				continue
+			}
+			if len(rule.GetKinds()) > 0 && !slices.Contains(rule.GetKinds(), alb.GetKind()) {
+				continue
+			}
+			return rule
+		}
+	}
+	return nil
+}
+
+// decideDownloads runs the rules over new releases of the artists, every release is decided once
+func decideDownloads(rules []*artist.DownloadRule, artists []*artist.Artist) []*artist.DownloadDecision {
+	var decisions []*artist.DownloadDecision
+	seen := make(map[string]bool)
+	for _, art := range artists {
+		for _, alb := range art.GetAlbums() {
+			if alb.GetAlbumId() == "" || alb.GetSyncState() != 1 || seen[alb.GetAlbumId()] {
+				continue
+			}
+			rule := matchRule(rules, art.GetArtistId(), alb)
+			if rule == nil {
+				continue
+			}
+			seen[alb.GetAlbumId()] = true
+			decisions = append(decisions, &artist.DownloadDecision{
+				ArtistId: art.GetArtistId(),
+				Album:    alb,
+				RuleId:   rule.GetId(),
+				Action:   rule.GetAction(),
+				Quality:  rule.GetQuality(),
+			})
+		}
+	}
+	return decisions
+}
+
+// applyDownloadRules queues the downloads the rules ask for after a sync, one job per quality
+func applyDownloadRules(ctx context.Context, siteId uint32, artists []*artist.Artist) []int64 {
+	rules, err := ListDownloadRulesDb(ctx, siteId, "", true)
+	if err != nil {
+		log.Println(err)
+		return nil
+	}
+	if len(rules) == 0 {
+		return nil
+	}
+
+	var qualities []string
+	mIds := make(map[string][]string)
+	for _, decision := range decideDownloads(rules, artists) {
+		if decision.GetAction() != ruleActionDownload {
+			continue
+		}
+		id := decision.GetAlbum().GetAlbumId()
+		if siteId == siteYoutube {
+			// DownloadVideos ждет канал;видео
+			id = decision.GetArtistId() + ";" + id
+		}
+		if _, ok := mIds[decision.GetQuality()]; !ok {
+			qualities = append(qualities, decision.GetQuality())
+		}
+		mIds[decision.GetQuality()] = append(mIds[decision.GetQuality()], id)
+	}
+
+	var jobIds []int64
+	for _, quality := range qualities {
+		jobId, er := InsertJobDb(ctx, &artist.SubmitJobRequest{
+			SiteId:       siteId,
+			JobType:      jobTypeDownloadAlbums,
+			AlbumIds:     mIds[quality],
+			TrackQuality: quality,
+		})
+		if er != nil {
+			log.Println(er)
+			continue
+		}
+		if er = enqueueJob(ctx, jobId); er != nil {
+			log.Println(er)
+			continue
+		}
+		jobIds = append(jobIds, jobId)
+		fmt.Printf("siteId: %v, auto download of %v release(s) as %v, job %v\n", siteId, len(mIds[quality]), quality, jobId)
+	}
+	return jobIds
+}
+
+// PreviewDownloadRules is the dry run: what the rules would do with the releases that are new now
+func PreviewDownloadRules(ctx context.Context, siteId uint32, artistId string, drafts []*artist.DownloadRule) ([]*artist.DownloadDecision, error) {
+	rules := drafts
+	if len(rules) == 0 {
+		var err error
+		rules, err = ListDownloadRulesDb(ctx, siteId, "", true)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	artists, err := getPendingReleasesDb(ctx, siteId, artistId)
+	if err != nil {
+		return nil, err
+	}
+	return decideDownloads(rules, artists), nil
+}
+
+func getPendingReleasesDb(ctx context.Context, siteId uint32, artistId string) ([]*artist.Artist, error) {
+	query, ok := pendingReleaseQueries[siteId]
+	if !ok {
+		return nil, nil
+	}
+
+	db, err := sql.Open(sqlite3, fmt.Sprintf("file:%v?cache=shared&mode=ro", dbFile))
+	if err != nil {
+		log.Println(err)
+	}
+	defer func(db *sql.DB) {
+		err = db.Close()
+		if err != nil {
+			log.Println(err)
+		}
+	}(db)
+
+	args := []interface{}{siteId}
+	if artistId != "" {
+		// первая колонка и есть id артиста или канала
+		query += " and " + strings.TrimSuffix(strings.Fields(query)[1], ",") + " = ?"
+		args = append(args, artistId)
+	}
+
+	rows, err := db.QueryContext(ctx, query+" order by 4 desc;", args...)
+	if err != nil {
+		return nil, err
+	}
+	defer func(rows *sql.Rows) {
+		err = rows.Close()
+		if err != nil {
+			log.Println(err)
+		}
+	}(rows)
+
+	var artists []*artist.Artist
+	mArtists := make(map[string]*artist.Artist)
+	for rows.Next() {
+		var (
+			artId string
+			alb   = artist.Album{SyncState: 1}
+		)
+		if er := rows.Scan(&artId, &alb.AlbumId, &alb.Title, &alb.ReleaseDate, &alb.ReleaseType, &alb.Kind); er != nil {
+			log.Println(er)
+			continue
+		}
+		art, ok := mArtists[artId]
+		if !ok {
+			art = &artist.Artist{SiteId: siteId, ArtistId: artId}
+			mArtists[artId] = art
+			artists = append(artists, art)
+		}
+		alb.ArtistIds = []string{artId}
+		art.Albums = append(art.Albums, &alb)
+	}
+	return artists, rows.Err()
+}
+
+func marshalInts(values []int32) string {
+	if len(values) == 0 {
+		return ""
+	}
+	res, err := json.Marshal(values)
+	if err != nil {
+		log.Println(err)
+	}
+	return string(res)
+}
+
+func unmarshalInts(raw string) []int32 {
+	if raw == "" {
+		return nil
+	}
+	var values []int32
+	if err := json.Unmarshal([]byte(raw), &values); err != nil {
+		log.Println(err)
+	}
+	return values
+}
+
+const downloadRuleColumns = "rule_id, siteId, artistId, ifnull(releaseTypes,''), ifnull(kinds,''), action, ifnull(quality,''), enabled, created"
+
+func scanDownloadRule(row interface{ Scan(dest ...any) error }) (*artist.DownloadRule, error) {
+	var (
+		rule                artist.DownloadRule
+		releaseTypes, kinds string
+	)
+	err := row.Scan(&rule.Id, &rule.SiteId, &rule.ArtistId, &releaseTypes, &kinds, &rule.Action, &rule.Quality, &rule.Enabled, &rule.Created)
+	if err != nil {
+		return nil, err
+	}
+	rule.ReleaseTypes = unmarshalInts(releaseTypes)
+	rule.Kinds = unmarshalInts(kinds)
+	return &rule, nil
+}
+
+// ListDownloadRulesDb returns rules in the order they are checked within the artist and the site groups
+func ListDownloadRulesDb(ctx context.Context, siteId uint32, artistId string, enabledOnly bool) ([]*artist.DownloadRule, error) {
+	db, err := sql.Open(sqlite3, fmt.Sprintf("file:%v?cache=shared&mode=ro", dbFile))
+	if err != nil {
+		log.Println(err)
+	}
+	defer func(db *sql.DB) {
+		err = db.Close()
+		if err != nil {
+			log.Println(err)
+		}
+	}(db)
+
+	var (
+		sb   strings.Builder
+		args []interface{}
+	)
+	sb.WriteString("select " + downloadRuleColumns + " from main.downloadRule where 1 = 1")
+	if siteId != 0 {
+		sb.WriteString(" and siteId = ?")
+		args = append(args, siteId)
+	}
+	if artistId != "" {
+		sb.WriteString(" and artistId = ?")
+		args = append(args, artistId)
+	}
+	if enabledOnly {
+		sb.WriteString(" and enabled = 1")
+	}
+	sb.WriteString(" order by siteId, rule_id;")
+
+	rows, err := db.QueryContext(ctx, sb.String(), args...)
+	if err != nil {
+		return nil, err
+	}
+	defer func(rows *sql.Rows) {
+		err = rows.Close()
+		if err != nil {
+			log.Println(err)
+		}
+	}(rows)
+
+	var rules []*artist.DownloadRule
+	for rows.Next() {
+		rule, er := scanDownloadRule(rows)
+		if er != nil {
+			log.Println(er)
+		} else {
+			rules = append(rules, rule)
+		}
+	}
+	return rules, rows.Err()
+}
+
+// SaveDownloadRuleDb inserts a rule with id 0 or replaces the stored one, nil is returned when there is no such rule
+func SaveDownloadRuleDb(ctx context.Context, rule *artist.DownloadRule) (*artist.DownloadRule, error) {
+	txMu.Lock()
+	defer txMu.Unlock()
+
+	db, err := sql.Open(sqlite3, fmt.Sprintf("file:%v?_foreign_keys=true&cache=shared&mode=rw", dbFile))
+	if err != nil {
+		log.Println(err)
+	}
+	defer func(db *sql.DB) {
+		err = db.Close()
+		if err != nil {
+			log.Println(err)
+		}
+	}(db)
+
+	args := []interface{}{rule.GetSiteId(), rule.GetArtistId(), marshalInts(rule.GetReleaseTypes()), marshalInts(rule.GetKinds()), rule.GetAction(), rule.GetQuality(), rule.GetEnabled()}
+	var row *sql.Row
+	if rule.GetId() == 0 {
+		row = db.QueryRowContext(ctx, "insert into main.downloadRule(siteId, artistId, releaseTypes, kinds, action, quality, enabled) values (?,?,nullif(?, ''),nullif(?, ''),?,nullif(?, ''),?) returning "+downloadRuleColumns+";", args...)
+	} else {
+		row = db.QueryRowContext(ctx, "update main.downloadRule set siteId = ?, artistId = ?, releaseTypes = nullif(?, ''), kinds = nullif(?, ''), action = ?, quality = nullif(?, ''), enabled = ? where rule_id = ? returning "+downloadRuleColumns+";", append(args, rule.GetId())...)
+	}
+
+	res, err := scanDownloadRule(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	return res, err
+}
+
+func DeleteDownloadRuleDb(ctx context.Context, ruleId int64) (int64, error) {
+	txMu.Lock()
+	defer txMu.Unlock()
+
+	db, err := sql.Open(sqlite3, fmt.Sprintf("file:%v?_foreign_keys=true&cache=shared&mode=rw", dbFile))
+	if err != nil {
+		log.Println(err)
+	}
+	defer func(db *sql.DB) {
+		err = db.Close()
+		if err != nil {
+			log.Println(err)
+		}
+	}(db)
+
+	res, err := db.ExecContext(ctx, "delete from main.downloadRule where rule_id = ?;", ruleId)
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected()
+}
@@ -0,0 +1,99 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	"github.com/v0vc/go-music-grpc/artist"
+)
+
+func TestDecideDownloads(t *testing.T) {
+	rules := []*artist.DownloadRule{
+		{Id: 1, ReleaseTypes: []int32{0}, Quality: "flac"},
+		{Id: 2, ArtistId: "art2", Action: ruleActionIgnore},
+		{Id: 3, ReleaseTypes: []int32{1}, Quality: "mid"},
+	}
+	art1 := &artist.Artist{ArtistId: "art1", Albums: []*artist.Album{
+		{AlbumId: "a1", ReleaseType: 0, SyncState: 1},
+		{AlbumId: "a2", ReleaseType: 1, SyncState: 1},
+		{AlbumId: "a3", ReleaseType: 4, SyncState: 1},
+		{AlbumId: "a4", ReleaseType: 0},
+		{ReleaseType: 0, SyncState: 1},
+	}}
+	art2 := &artist.Artist{ArtistId: "art2", Albums: []*artist.Album{
+		{AlbumId: "b1", ReleaseType: 0, SyncState: 1},
+		// общий релиз решается один раз
+		{AlbumId: "a1", ReleaseType: 0, SyncState: 1},
+	}}
+
+	decisions := decideDownloads(rules, []*artist.Artist{art1, art2})
+	want := []struct {
+		albumId string
+		ruleId  int64
+		action  int32
+	}{
+		{"a1", 1, ruleActionDownload},
+		{"a2", 3, ruleActionDownload},
+		{"b1", 2, ruleActionIgnore},
+	}
+	if len(decisions) != len(want) {
+		t.Fatalf("got %v decisions, want %v: %v", len(decisions), len(want), decisions)
+	}
+	for i, w := range want {
+		d := decisions[i]
+		if d.GetAlbum().GetAlbumId() != w.albumId || d.GetRuleId() != w.ruleId || d.GetAction() != w.action {
+			t.Errorf("decision %v = %v, want %+v", i, d, w)
+		}
+	}
+}
+
+func TestMatchRule(t *testing.T) {
+	rules := []*artist.DownloadRule{
+		{Id: 1, Quality: "best"},
+		{Id: 2, ArtistId: "ch2", Kinds: []int32{videoKindLive}, Action: ruleActionIgnore},
+		{Id: 3, ArtistId: "ch1", Quality: "bestaudio"},
+		{Id: 4, Kinds: []int32{videoKindShort}, Action: ruleActionIgnore},
+	}
+	tests := []struct {
+		artistId string
+		kind     int32
+		want     int64
+	}{
+		// правило канала важнее более старого правила сайта
+		{"ch1", videoKindShort, 3},
+		{"ch2", videoKindLive, 2},
+		{"ch2", videoKindShort, 1},
+		{"ch3", videoKindRegular, 1},
+	}
+	for _, tt := range tests {
+		rule := matchRule(rules, tt.artistId, &artist.Album{Kind: tt.kind})
+		if rule.GetId() != tt.want {
+			t.Errorf("matchRule(%v, kind %v) = %v, want %v", tt.artistId, tt.kind, rule.GetId(), tt.want)
+		}
+	}
+	if rule := matchRule(rules[1:2], "ch1", &artist.Album{}); rule != nil {
+		t.Errorf("rule of another channel matched: %v", rule)
+	}
+}
+
+func TestValidateDownloadRuleQuality(t *testing.T) {
+	p := newFakeProvider(t)
+	tests := []struct {
+		siteId  uint32
+		quality string
+		ok      bool
+	}{
+		{siteZvuk, "flac", true},
+		{siteZvuk, "best", false},
+		{siteYoutube, "bestvideo+bestaudio", true},
+		{siteYoutube, "bestaudio", true},
+		{siteYoutube, "flac", false},
+		{siteYoutube, "", false},
+	}
+	for _, tt := range tests {
+		err := validateDownloadRule(context.Background(), p, &artist.DownloadRule{SiteId: tt.siteId, Quality: tt.quality})
+		if (err == nil) != tt.ok {
+			t.Errorf("site %v quality %q: %v", tt.siteId, tt.quality, err)
+		}
+	}
+}
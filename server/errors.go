@@ -0,0 +1,319 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+const (
+	providerZvuk    = "zvuk"
+	providerYoutube = "youtube"
+	errorDomain     = "go-music-grpc"
+)
+
+type ErrorKind int
+
+const (
+	ErrUnknown ErrorKind = iota
+	// ErrThrottled провайдер просит притормозить (zvuk 418, 429)
+	ErrThrottled
+	// ErrQuotaExceeded кончилась дневная квота youtube
+	ErrQuotaExceeded
+	// ErrUnauthenticated токен протух или неверный ключ
+	ErrUnauthenticated
+	// ErrApiChanged апи ответило 403 на то, что раньше работало
+	ErrApiChanged
+	// ErrNotFound артиста, релиза или видео больше нет
+	ErrNotFound
+	// ErrUnavailable сеть или 5xx
+	ErrUnavailable
+)
+
+var errorReasons = map[ErrorKind]string{
+	ErrUnknown:         "UNKNOWN",
+	ErrThrottled:       "THROTTLED",
+	ErrQuotaExceeded:   "QUOTA_EXCEEDED",
+	ErrUnauthenticated: "TOKEN_EXPIRED",
+	ErrApiChanged:      "API_CHANGED",
+	ErrNotFound:        "NOT_FOUND",
+	ErrUnavailable:     "UNAVAILABLE",
+}
+
+// ProviderError is a failure of a zvuk or youtube call that keeps enough context to pick a grpc code
+type ProviderError struct {
+	Kind       ErrorKind
+	Provider   string
+	ArtistId   string
+	StatusCode int
+	RetryAfter time.Duration
+	Err        error
+}
+
+func (e *ProviderError) Error() string {
+	var sb strings.Builder
+	sb.WriteString(e.Provider)
+	sb.WriteString(": ")
+	sb.WriteString(strings.ToLower(strings.ReplaceAll(errorReasons[e.Kind], "_", " ")))
+	if e.StatusCode != 0 {
+		sb.WriteString(fmt.Sprintf(" (http %d)", e.StatusCode))
+	}
+	if e.ArtistId != "" {
+		sb.WriteString(", artist: " + e.ArtistId)
+	}
+	if e.Err != nil {
+		sb.WriteString(": " + e.Err.Error())
+	}
+	return sb.String()
+}
+
+func (e *ProviderError) Unwrap() error {
+	return e.Err
+}
+
+func newProviderError(provider string, kind ErrorKind, err error) *ProviderError {
+	return &ProviderError{Kind: kind, Provider: provider, Err: err}
+}
+
+// httpError maps a non successful provider response to a typed error
+func httpError(provider string, response *http.Response) *ProviderError {
+	pe := &ProviderError{Provider: provider, StatusCode: response.StatusCode}
+
+	switch response.StatusCode {
+	case http.StatusTeapot, http.StatusTooManyRequests:
+		pe.Kind = ErrThrottled
+		pe.RetryAfter = retryAfter(response, 30*time.Second)
+	case http.StatusUnauthorized:
+		pe.Kind = ErrUnauthenticated
+	case http.StatusForbidden:
+		pe.Kind = ErrApiChanged
+	case http.StatusNotFound:
+		pe.Kind = ErrNotFound
+	default:
+		if response.StatusCode >= http.StatusInternalServerError {
+			pe.Kind = ErrUnavailable
+		}
+	}
+
+	if provider == providerYoutube {
+		youtubeErrorReason(pe, response)
+	}
+
+	return pe
+}
+
+// youtubeErrorReason уточняет тип ошибки по телу ответа апи ютуба
+func youtubeErrorReason(pe *ProviderError, response *http.Response) {
+	var body struct {
+		Error struct {
+			Message string `json:"message,omitempty"`
+			Errors  []struct {
+				Reason string `json:"reason,omitempty"`
+			} `json:"errors,omitempty"`
+		} `json:"error,omitempty"`
+	}
+
+	raw, err := io.ReadAll(io.LimitReader(response.Body, 64*1024))
+	if err != nil || json.Unmarshal(raw, &body) != nil {
+		return
+	}
+
+	if body.Error.Message != "" {
+		pe.Err = errors.New(body.Error.Message)
+	}
+
+	for _, e := range body.Error.Errors {
+		switch e.Reason {
+		case "quotaExceeded", "dailyLimitExceeded":
+			pe.Kind = ErrQuotaExceeded
+			pe.RetryAfter = time.Until(nextPacificMidnight(time.Now()))
+		case "rateLimitExceeded", "userRateLimitExceeded":
+			pe.Kind = ErrThrottled
+			if pe.RetryAfter == 0 {
+				pe.RetryAfter = 30 * time.Second
+			}
+		case "keyInvalid", "keyExpired", "forbidden":
+			pe.Kind = ErrUnauthenticated
+		case "channelNotFound", "playlistNotFound", "videoNotFound":
+			pe.Kind = ErrNotFound
+		}
+	}
+}
+
+// graphqlError достает код ответа из ошибки graphql клиента, он отдает его только текстом
+func graphqlError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return err
+	}
+	var known *ProviderError
+	if errors.As(err, &known) {
+		// уже разобрано транспортом, например не вышло обновить токен
+		return known
+	}
+
+	const marker = "non-200 status code: "
+	msg := err.Error()
+	idx := strings.Index(msg, marker)
+	if idx == -1 {
+		return newProviderError(providerZvuk, ErrUnavailable, err)
+	}
+
+	code, er := strconv.Atoi(strings.TrimSpace(msg[idx+len(marker):]))
+	if er != nil {
+		return newProviderError(providerZvuk, ErrUnavailable, err)
+	}
+
+	pe := httpError(providerZvuk, &http.Response{StatusCode: code, Header: http.Header{}})
+	pe.Err = err
+	return pe
+}
+
+func retryAfter(response *http.Response, def time.Duration) time.Duration {
+	value := response.Header.Get("Retry-After")
+	if value == "" {
+		return def
+	}
+	if sec, err := strconv.Atoi(value); err == nil {
+		return time.Duration(sec) * time.Second
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		return time.Until(date)
+	}
+	return def
+}
+
+// pacific - по этому времени google сбрасывает дневную квоту
+func pacific() *time.Location {
+	loc, err := time.LoadLocation("America/Los_Angeles")
+	if err != nil {
+		loc = time.FixedZone("PST", -8*60*60)
+	}
+	return loc
+}
+
+func nextPacificMidnight(now time.Time) time.Time {
+	loc := pacific()
+	t := now.In(loc)
+	return time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, loc)
+}
+
+// withArtist remembers which artist the failed call was made for
+func withArtist(err error, artistId string) error {
+	var pe *ProviderError
+	if errors.As(err, &pe) && pe.ArtistId == "" {
+		pe.ArtistId = artistId
+	}
+	return err
+}
+
+// toStatus converts any error of the provider code to a grpc status with details for the ui
+func toStatus(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	var pe *ProviderError
+	if !errors.As(err, &pe) {
+		switch {
+		case errors.Is(err, context.Canceled):
+			return status.Error(codes.Canceled, err.Error())
+		case errors.Is(err, context.DeadlineExceeded):
+			return status.Error(codes.DeadlineExceeded, err.Error())
+		}
+		var ne net.Error
+		if errors.As(err, &ne) {
+			return status.Error(codes.Unavailable, "provider is unavailable")
+		}
+		return status.Errorf(
+			codes.Internal,
+			"Internal error",
+		)
+	}
+
+	var (
+		code codes.Code
+		msg  string
+	)
+
+	switch pe.Kind {
+	case ErrThrottled:
+		code = codes.ResourceExhausted
+		msg = fmt.Sprintf("%s throttled, retry in %v", pe.Provider, pe.RetryAfter.Round(time.Second))
+	case ErrQuotaExceeded:
+		code = codes.ResourceExhausted
+		msg = fmt.Sprintf("%s quota exceeded, retry in %v", pe.Provider, pe.RetryAfter.Round(time.Minute))
+	case ErrUnauthenticated:
+		code = codes.Unauthenticated
+		msg = fmt.Sprintf("%s token expired or invalid", pe.Provider)
+	case ErrApiChanged:
+		code = codes.FailedPrecondition
+		msg = fmt.Sprintf("%s api was changed, please report", pe.Provider)
+	case ErrNotFound:
+		code = codes.NotFound
+		msg = fmt.Sprintf("%s: not found", pe.Provider)
+	case ErrUnavailable:
+		code = codes.Unavailable
+		msg = fmt.Sprintf("%s is unavailable", pe.Provider)
+	default:
+		code = codes.Internal
+		msg = fmt.Sprintf("%s: unexpected response", pe.Provider)
+	}
+	if pe.ArtistId != "" {
+		msg += ", artist: " + pe.ArtistId
+	}
+
+	metadata := map[string]string{"provider": pe.Provider}
+	if pe.ArtistId != "" {
+		metadata["artistId"] = pe.ArtistId
+	}
+	if pe.StatusCode != 0 {
+		metadata["httpStatus"] = strconv.Itoa(pe.StatusCode)
+	}
+
+	st := status.New(code, msg)
+	info := &errdetails.ErrorInfo{
+		Reason:   errorReasons[pe.Kind],
+		Domain:   errorDomain,
+		Metadata: metadata,
+	}
+
+	var withDetails *status.Status
+	if pe.RetryAfter > 0 {
+		withDetails, err = st.WithDetails(info, &errdetails.RetryInfo{RetryDelay: durationpb.New(pe.RetryAfter)})
+	} else {
+		withDetails, err = st.WithDetails(info)
+	}
+	if err != nil {
+		return st.Err()
+	}
+	return withDetails.Err()
+}
+
+// rollbackWith откатывает транзакцию и отдает исходную ошибку, а не результат отката
+func rollbackWith(tx *sql.Tx, err error) error {
+	er := tx.Rollback()
+	if er != nil {
+		log.Println(er)
+	}
+	return err
+}
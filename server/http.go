@@ -0,0 +1,148 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"net"
+	"net/http"
+	"time"
+)
+
+const (
+	// apiTimeout ограничивает весь вызов апи вместе с повторами, на скачивание файлов не действует
+	apiTimeout    = 3 * time.Minute
+	headerTimeout = 30 * time.Second
+	retryAttempts = 5
+	retryBase     = time.Second
+	// если сайт просит ждать дольше, отдаем ErrThrottled наверх, пусть решает вызывающий
+	retryMaxDelay = time.Minute
+)
+
+var (
+	// providerTransport держит соединения ко всем сайтам, клиенты ниже его только оборачивают
+	providerTransport = &http.Transport{
+		Proxy: http.ProxyFromEnvironment,
+		DialContext: (&net.Dialer{
+			Timeout:   10 * time.Second,
+			KeepAlive: 30 * time.Second,
+		}).DialContext,
+		ForceAttemptHTTP2:     true,
+		MaxIdleConns:          64,
+		MaxIdleConnsPerHost:   8,
+		IdleConnTimeout:       90 * time.Second,
+		TLSHandshakeTimeout:   10 * time.Second,
+		ResponseHeaderTimeout: headerTimeout,
+		ExpectContinueTimeout: time.Second,
+	}
+
+	zvukClient = &http.Client{
+		Jar:       jar,
+		Timeout:   apiTimeout,
+		Transport: &authTransport{base: newRetryTransport(&Transport{base: &limitedTransport{limiter: zvukLimiter, base: providerTransport}})},
+	}
+	// zvukFileClient без общего таймаута: flac альбом качается дольше любого разумного лимита
+	zvukFileClient = &http.Client{
+		Jar:       jar,
+		Transport: &authTransport{base: newRetryTransport(&Transport{base: &limitedTransport{limiter: zvukLimiter, base: providerTransport}})},
+	}
+	// zvukLoginClient без authTransport: 401 на входе значит неверный пароль, а не повод входить снова
+	zvukLoginClient = &http.Client{
+		Jar:       jar,
+		Timeout:   apiTimeout,
+		Transport: newRetryTransport(&Transport{base: &limitedTransport{limiter: zvukLimiter, base: providerTransport}}),
+	}
+	youtubeClient = &http.Client{
+		Timeout:   apiTimeout,
+		Transport: newRetryTransport(&quotaTransport{base: &limitedTransport{limiter: youtubeLimiter, base: providerTransport}}),
+	}
+	// mediaClient для картинок с cdn, лимиты апи на них не распространяются
+	mediaClient = &http.Client{
+		Timeout:   apiTimeout,
+		Transport: newRetryTransport(providerTransport),
+	}
+)
+
+// retryTransport repeats a request on network errors, 418, 429 and 5xx with exponential backoff and jitter,
+// Retry-After of the site wins when it is longer. The last response is returned as is, so the caller still
+// maps it to a ProviderError.
+type retryTransport struct {
+	base     http.RoundTripper
+	attempts int
+}
+
+func newRetryTransport(base http.RoundTripper) *retryTransport {
+	return &retryTransport{base: base, attempts: retryAttempts}
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	for attempt := 1; ; attempt++ {
+		try := req
+		if attempt > 1 && req.Body != nil {
+			try = req.Clone(req.Context())
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			try.Body = body
+		}
+
+		response, err := t.base.RoundTrip(try)
+		if attempt == t.attempts || !canRetry(req, response, err) {
+			return response, err
+		}
+
+		delay := backoff(attempt)
+		if response != nil {
+			if wait := retryAfter(response, 0); wait > delay {
+				if wait > retryMaxDelay {
+					return response, err
+				}
+				delay = wait
+			}
+			_, _ = io.Copy(io.Discard, response.Body)
+			_ = response.Body.Close()
+			fmt.Printf("%v %v: got %v, attempt %d/%d, retry in %v\n", req.Method, req.URL.Host, response.StatusCode, attempt, t.attempts, delay)
+		} else {
+			fmt.Printf("%v %v: %v, attempt %d/%d, retry in %v\n", req.Method, req.URL.Host, err, attempt, t.attempts, delay)
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+	}
+}
+
+func canRetry(req *http.Request, response *http.Response, err error) bool {
+	if req.Context().Err() != nil {
+		return false
+	}
+	if req.Body != nil && req.GetBody == nil {
+		// тело уже прочитано, повторить нечем
+		return false
+	}
+	if err != nil {
+		var netErr net.Error
+		return errors.As(err, &netErr) || errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, io.EOF)
+	}
+
+	switch response.StatusCode {
+	case http.StatusTeapot, http.StatusTooManyRequests:
+		return true
+	case http.StatusNotImplemented, http.StatusHTTPVersionNotSupported:
+		return false
+	default:
+		return response.StatusCode >= http.StatusInternalServerError
+	}
+}
+
+// backoff is 1s, 2s, 4s... with up to half of it added at random, so parallel workers don't retry in step
+func backoff(attempt int) time.Duration {
+	delay := min(retryBase<<(attempt-1), retryMaxDelay)
+	return delay + time.Duration(rand.Int63n(int64(delay)/2+1))
+}
@@ -0,0 +1,466 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/v0vc/go-music-grpc/artist"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	jobTypeDownloadAlbums int32 = iota
+	jobTypeDownloadArtist
+	jobTypeSyncArtist
+	jobTypeBulkAdd
+)
+
+const (
+	jobStateQueued int32 = iota
+	jobStateRunning
+	jobStateDone
+	jobStateFailed
+	jobStateCancelled
+	jobStateInterrupted
+)
+
+var (
+	jobQueue   = make(chan int64, 1024)
+	jobCancels sync.Map
+
+	errJobQueueFull = errors.New("job queue is full, retry later")
+)
+
+type jobParams struct {
+	ArtistId     string   `json:"artistId,omitempty"`
+	ArtistIds    []string `json:"artistIds,omitempty"`
+	AlbumIds     []string `json:"albumIds,omitempty"`
+	TrackQuality string   `json:"trackQuality,omitempty"`
+	IsPl         bool     `json:"isPl,omitempty"`
+	IsAdd        bool     `json:"isAdd,omitempty"`
+}
+
+func InsertJobDb(ctx context.Context, req *artist.SubmitJobRequest) (int64, error) {
+	txMu.Lock()
+	defer txMu.Unlock()
+
+	db, err := sql.Open(sqlite3, fmt.Sprintf("file:%v?_foreign_keys=true&cache=shared&mode=rw", dbFile))
+	if err != nil {
+		log.Println(err)
+	}
+	defer func(db *sql.DB) {
+		err = db.Close()
+		if err != nil {
+			log.Println(err)
+		}
+	}(db)
+
+	params, err := json.Marshal(&jobParams{
+		ArtistId:     req.GetArtistId(),
+		ArtistIds:    req.GetArtistIds(),
+		AlbumIds:     req.GetAlbumIds(),
+		TrackQuality: req.GetTrackQuality(),
+		IsPl:         req.GetIsPl(),
+		IsAdd:        req.GetIsAdd(),
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	var jobId int64
+	err = db.QueryRowContext(ctx, "insert into main.job(siteId, jobType, state, params) values (?,?,?,?) returning job_id;", req.GetSiteId(), req.GetJobType(), jobStateQueued, string(params)).Scan(&jobId)
+	return jobId, err
+}
+
+func GetJobDb(ctx context.Context, jobId int64) (*artist.Job, error) {
+	db, err := sql.Open(sqlite3, fmt.Sprintf("file:%v?cache=shared&mode=ro", dbFile))
+	if err != nil {
+		log.Println(err)
+	}
+	defer func(db *sql.DB) {
+		err = db.Close()
+		if err != nil {
+			log.Println(err)
+		}
+	}(db)
+
+	row := db.QueryRowContext(ctx, "select job_id, siteId, jobType, state, params, progress, total, result, error, created, updated from main.job where job_id = ?;", jobId)
+	return scanJob(row)
+}
+
+func ListJobsDb(ctx context.Context, siteId uint32, states []int32, limit int32) ([]*artist.Job, error) {
+	db, err := sql.Open(sqlite3, fmt.Sprintf("file:%v?cache=shared&mode=ro", dbFile))
+	if err != nil {
+		log.Println(err)
+	}
+	defer func(db *sql.DB) {
+		err = db.Close()
+		if err != nil {
+			log.Println(err)
+		}
+	}(db)
+
+	var (
+		sb   strings.Builder
+		args []interface{}
+	)
+	sb.WriteString("select job_id, siteId, jobType, state, params, progress, total, result, error, created, updated from main.job where 1 = 1")
+	if siteId != 0 {
+		sb.WriteString(" and siteId = ?")
+		args = append(args, siteId)
+	}
+	if len(states) > 0 {
+		sb.WriteString(fmt.Sprintf(" and state in (?%v)", strings.Repeat(",?", len(states)-1)))
+		for _, st := range states {
+			args = append(args, st)
+		}
+	}
+	sb.WriteString(" order by job_id desc")
+	if limit > 0 {
+		sb.WriteString(" limit ?")
+		args = append(args, limit)
+	}
+	sb.WriteString(";")
+
+	rows, err := db.QueryContext(ctx, sb.String(), args...)
+	if err != nil {
+		return nil, err
+	}
+	defer func(rows *sql.Rows) {
+		err = rows.Close()
+		if err != nil {
+			log.Println(err)
+		}
+	}(rows)
+
+	var jobs []*artist.Job
+	for rows.Next() {
+		job, er := scanJob(rows)
+		if er != nil {
+			log.Println(er)
+		} else {
+			jobs = append(jobs, job)
+		}
+	}
+
+	return jobs, err
+}
+
+func scanJob(row interface{ Scan(dest ...any) error }) (*artist.Job, error) {
+	var (
+		job             artist.Job
+		params          string
+		result, jobErr  sql.NullString
+		created, update string
+	)
+
+	err := row.Scan(&job.Id, &job.SiteId, &job.JobType, &job.State, &params, &job.Progress, &job.Total, &result, &jobErr, &created, &update)
+	if err != nil {
+		return nil, err
+	}
+
+	var p jobParams
+	if er := json.Unmarshal([]byte(params), &p); er != nil {
+		log.Println(er)
+	}
+	job.ArtistId = p.ArtistId
+	job.ArtistIds = p.ArtistIds
+	job.AlbumIds = p.AlbumIds
+	job.TrackQuality = p.TrackQuality
+	job.IsPl = p.IsPl
+	if result.Valid && result.String != "" {
+		if er := json.Unmarshal([]byte(result.String), &job.Result); er != nil {
+			log.Println(er)
+		}
+	}
+	job.Error = jobErr.String
+	job.Created = created
+	job.Updated = update
+
+	return &job, nil
+}
+
+func getJobParamsDb(ctx context.Context, jobId int64) (*jobParams, error) {
+	db, err := sql.Open(sqlite3, fmt.Sprintf("file:%v?cache=shared&mode=ro", dbFile))
+	if err != nil {
+		log.Println(err)
+	}
+	defer func(db *sql.DB) {
+		err = db.Close()
+		if err != nil {
+			log.Println(err)
+		}
+	}(db)
+
+	var params string
+	err = db.QueryRowContext(ctx, "select params from main.job where job_id = ?;", jobId).Scan(&params)
+	if err != nil {
+		return nil, err
+	}
+
+	var p jobParams
+	return &p, json.Unmarshal([]byte(params), &p)
+}
+
+// SetJobStateDb moves the job to the new state only when it is in one of the from states
+func SetJobStateDb(ctx context.Context, jobId int64, state int32, from []int32, jobErr string) (int64, error) {
+	txMu.Lock()
+	defer txMu.Unlock()
+
+	db, err := sql.Open(sqlite3, fmt.Sprintf("file:%v?_foreign_keys=false&cache=shared&mode=rw", dbFile))
+	if err != nil {
+		log.Println(err)
+	}
+	defer func(db *sql.DB) {
+		err = db.Close()
+		if err != nil {
+			log.Println(err)
+		}
+	}(db)
+
+	args := []interface{}{state, jobErr, jobId}
+	for _, st := range from {
+		args = append(args, st)
+	}
+
+	res, err := db.ExecContext(ctx, fmt.Sprintf("update main.job set state = ?, error = nullif(?, ''), updated = CURRENT_TIMESTAMP where job_id = ? and state in (?%v);", strings.Repeat(",?", len(from)-1)), args...)
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected()
+}
+
+func setJobProgressDb(ctx context.Context, jobId int64, progress, total int32) {
+	txMu.Lock()
+	defer txMu.Unlock()
+
+	db, err := sql.Open(sqlite3, fmt.Sprintf("file:%v?_foreign_keys=false&cache=shared&mode=rw", dbFile))
+	if err != nil {
+		log.Println(err)
+	}
+	defer func(db *sql.DB) {
+		err = db.Close()
+		if err != nil {
+			log.Println(err)
+		}
+	}(db)
+
+	_, err = db.ExecContext(ctx, "update main.job set progress = ?, total = ?, updated = CURRENT_TIMESTAMP where job_id = ?;", progress, total, jobId)
+	if err != nil {
+		log.Println(err)
+	}
+}
+
+func finishJobDb(ctx context.Context, jobId int64, state int32, result map[string]string, jobErr string) {
+	txMu.Lock()
+	defer txMu.Unlock()
+
+	db, err := sql.Open(sqlite3, fmt.Sprintf("file:%v?_foreign_keys=false&cache=shared&mode=rw", dbFile))
+	if err != nil {
+		log.Println(err)
+	}
+	defer func(db *sql.DB) {
+		err = db.Close()
+		if err != nil {
+			log.Println(err)
+		}
+	}(db)
+
+	res, err := json.Marshal(result)
+	if err != nil {
+		log.Println(err)
+	}
+
+	_, err = db.ExecContext(ctx, "update main.job set state = ?, result = ?, error = nullif(?, ''), updated = CURRENT_TIMESTAMP where job_id = ?;", state, string(res), jobErr, jobId)
+	if err != nil {
+		log.Println(err)
+	}
+}
+
+// RecoverJobsDb marks jobs that were running when the server stopped as interrupted and returns queued ones
+func RecoverJobsDb(ctx context.Context) ([]int64, error) {
+	db, err := sql.Open(sqlite3, fmt.Sprintf("file:%v?_foreign_keys=false&cache=shared&mode=rw", dbFile))
+	if err != nil {
+		log.Println(err)
+	}
+	defer func(db *sql.DB) {
+		err = db.Close()
+		if err != nil {
+			log.Println(err)
+		}
+	}(db)
+
+	res, err := db.ExecContext(ctx, "update main.job set state = ?, error = 'server was stopped', updated = CURRENT_TIMESTAMP where state = ?;", jobStateInterrupted, jobStateRunning)
+	if err != nil {
+		return nil, err
+	}
+	aff, _ := res.RowsAffected()
+	if aff > 0 {
+		fmt.Printf("jobs interrupted by shutdown: %v\n", aff)
+	}
+
+	rows, err := db.QueryContext(ctx, "select job_id from main.job where state = ? order by job_id;", jobStateQueued)
+	if err != nil {
+		return nil, err
+	}
+	defer func(rows *sql.Rows) {
+		err = rows.Close()
+		if err != nil {
+			log.Println(err)
+		}
+	}(rows)
+
+	var ids []int64
+	for rows.Next() {
+		var id int64
+		if er := rows.Scan(&id); er != nil {
+			log.Println(er)
+		} else {
+			ids = append(ids, id)
+		}
+	}
+	return ids, err
+}
+
+// enqueueJob hands the queued job to runJobs, when the queue is full the job is failed so it can be retried later
+func enqueueJob(ctx context.Context, jobId int64) error {
+	select {
+	case jobQueue <- jobId:
+		return nil
+	default:
+		if _, err := SetJobStateDb(ctx, jobId, jobStateFailed, []int32{jobStateQueued}, errJobQueueFull.Error()); err != nil {
+			log.Println(err)
+		}
+		return status.Error(codes.ResourceExhausted, errJobQueueFull.Error())
+	}
+}
+
+func cancelJob(ctx context.Context, jobId int64) (int64, error) {
+	aff, err := SetJobStateDb(ctx, jobId, jobStateCancelled, []int32{jobStateQueued}, "")
+	if err != nil || aff > 0 {
+		return aff, err
+	}
+	cancel, ok := jobCancels.Load(jobId)
+	if !ok {
+		return 0, nil
+	}
+	cancel.(context.CancelFunc)()
+	return 1, nil
+}
+
+func runJobs() {
+	for jobId := range jobQueue {
+		runJob(jobId)
+	}
+}
+
+func runJob(jobId int64) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// отмену кладем до смены состояния, иначе CancelJob увидит running без нее
+	jobCancels.Store(jobId, cancel)
+	defer jobCancels.Delete(jobId)
+
+	aff, err := SetJobStateDb(ctx, jobId, jobStateRunning, []int32{jobStateQueued}, "")
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	if aff == 0 {
+		// отменили, пока стояла в очереди
+		return
+	}
+
+	job, err := GetJobDb(ctx, jobId)
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	params, err := getJobParamsDb(ctx, jobId)
+	if err != nil {
+		finishJobDb(ctx, jobId, jobStateFailed, nil, err.Error())
+		return
+	}
+
+	fmt.Printf("siteId: %v, job %v started\n", job.GetSiteId(), jobId)
+
+	var result map[string]string
+	switch job.GetJobType() {
+	case jobTypeDownloadAlbums, jobTypeDownloadArtist:
+		if job.GetJobType() == jobTypeDownloadArtist {
+			result, err = downloadArtist(ctx, job.GetSiteId(), params.ArtistId, params.TrackQuality, jobDownloadProgress(ctx, jobId))
+		} else {
+			result, err = downloadAlbums(ctx, job.GetSiteId(), params.AlbumIds, params.TrackQuality, params.IsPl, jobDownloadProgress(ctx, jobId))
+		}
+	case jobTypeSyncArtist:
+		result = make(map[string]string)
+		_, err = syncArtists(ctx, &artist.SyncArtistRequest{
+			SiteId:   job.GetSiteId(),
+			ArtistId: params.ArtistId,
+			IsAdd:    params.IsAdd,
+		}, syncTriggerJob, func(event *artist.SyncArtistEvent) {
+			switch event.GetEventType() {
+			case syncEventStarted:
+				setJobProgressDb(ctx, jobId, event.GetCurrent()-1, event.GetTotal())
+			case syncEventAlbums:
+				result[event.GetArtistId()] = strconv.Itoa(len(event.GetAlbums()))
+			case syncEventError:
+				result[event.GetArtistId()] = event.GetError()
+			case syncEventSummary:
+				setJobProgressDb(ctx, jobId, event.GetTotal(), event.GetTotal())
+			}
+		})
+	case jobTypeBulkAdd:
+		result, err = bulkAddArtists(ctx, jobId, job.GetSiteId(), params.ArtistIds)
+	default:
+		err = fmt.Errorf("unknown job type: %v", job.GetJobType())
+	}
+
+	switch {
+	case errors.Is(err, context.Canceled):
+		// отмена, пришедшая после всей работы, ничего не прервала, такая задача просто выполнена
+		finishJobDb(context.WithoutCancel(ctx), jobId, jobStateCancelled, result, "")
+		fmt.Printf("siteId: %v, job %v cancelled\n", job.GetSiteId(), jobId)
+	case err != nil:
+		finishJobDb(context.WithoutCancel(ctx), jobId, jobStateFailed, result, err.Error())
+		log.Printf("Job %v error: %v", jobId, err)
+	default:
+		finishJobDb(context.WithoutCancel(ctx), jobId, jobStateDone, result, "")
+		fmt.Printf("siteId: %v, job %v completed\n", job.GetSiteId(), jobId)
+	}
+}
+
+func jobDownloadProgress(ctx context.Context, jobId int64) DownloadProgress {
+	var done, total int32
+	return func(event *artist.DownloadEvent) {
+		switch event.GetEventType() {
+		case downloadEventQueued:
+			total++
+		case downloadEventSkipped, downloadEventFailed, downloadEventFinished:
+			done++
+		default:
+			return
+		}
+		setJobProgressDb(ctx, jobId, done, total)
+	}
+}
+
+func retryJob(ctx context.Context, jobId int64) error {
+	aff, err := SetJobStateDb(ctx, jobId, jobStateQueued, []int32{jobStateFailed, jobStateCancelled, jobStateInterrupted}, "")
+	if err != nil {
+		return err
+	}
+	if aff == 0 {
+		return status.Error(codes.FailedPrecondition, "only failed, cancelled or interrupted jobs can be retried")
+	}
+	return enqueueJob(ctx, jobId)
+}
@@ -0,0 +1,180 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/panjf2000/ants/v2"
+)
+
+const (
+	defaultWorkers = 1
+	defaultZvukRps = 2
+	defaultYouRps  = 5
+	// после 418/429 скорость делится пополам, но не ниже maxRate / limiterMinShare
+	limiterMinShare = 16
+	// через столько после штрафа без новых 418/429 скорость снова удваивается
+	limiterRecovery = time.Minute
+)
+
+var (
+	// txMu пускает в базу одного писателя: sqlite с shared cache не ждет второго, а сразу отвечает "table is locked"
+	txMu sync.Mutex
+	// syncPools по пулу на сайт, чтобы синк звука не стоял в очереди за ютубом
+	syncPools = make(map[uint32]*ants.Pool)
+
+	zvukLimiter    = newLimiter(providerZvuk, defaultZvukRps)
+	youtubeLimiter = newLimiter(providerYoutube, defaultYouRps)
+)
+
+// limiter is a token bucket shared by all requests to one site. On 418/429 it stops for Retry-After,
+// halves the rate and then doubles it back every limiterRecovery without new complaints.
+type limiter struct {
+	mu          sync.Mutex
+	name        string
+	rate        float64
+	maxRate     float64
+	burst       float64
+	tokens      float64
+	last        time.Time
+	blockedTill time.Time
+	recoverAt   time.Time
+}
+
+func newLimiter(name string, rps int) *limiter {
+	l := &limiter{name: name}
+	l.setRate(rps)
+	return l
+}
+
+func (l *limiter) setRate(rps int) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.maxRate = float64(max(rps, 1))
+	l.rate = l.maxRate
+	l.burst = l.maxRate
+	l.tokens = l.burst
+}
+
+// reserve takes a token and returns how long to wait before using it
+func (l *limiter) reserve(now time.Time) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.rate < l.maxRate && now.After(l.recoverAt) {
+		l.rate = min(l.maxRate, l.rate*2)
+		l.recoverAt = now.Add(limiterRecovery)
+		fmt.Printf("%v: rate is back to %.2f rps\n", l.name, l.rate)
+	}
+
+	start := now
+	if start.Before(l.blockedTill) {
+		start = l.blockedTill
+	}
+	if start.After(l.last) {
+		l.tokens = min(l.burst, l.tokens+start.Sub(l.last).Seconds()*l.rate)
+		l.last = start
+	}
+	l.tokens--
+
+	wait := start.Sub(now)
+	if l.tokens < 0 {
+		wait += time.Duration(-l.tokens / l.rate * float64(time.Second))
+	}
+	return wait
+}
+
+func (l *limiter) Wait(ctx context.Context) error {
+	wait := l.reserve(time.Now())
+	if wait <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+func (l *limiter) penalize(pause time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	l.rate = max(l.maxRate/limiterMinShare, l.rate/2)
+	l.tokens = 0
+	if till := now.Add(pause); till.After(l.blockedTill) {
+		l.blockedTill = till
+	}
+	l.recoverAt = l.blockedTill.Add(limiterRecovery)
+	fmt.Printf("%v: throttled, pause %v, rate %.2f rps\n", l.name, pause, l.rate)
+}
+
+// limitedTransport waits for the limiter before every request and slows it down on 418/429
+type limitedTransport struct {
+	limiter *limiter
+	base    http.RoundTripper
+}
+
+func (t *limitedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := t.limiter.Wait(req.Context()); err != nil {
+		return nil, err
+	}
+
+	response, err := t.base.RoundTrip(req)
+	if err == nil && (response.StatusCode == http.StatusTeapot || response.StatusCode == http.StatusTooManyRequests) {
+		t.limiter.penalize(retryAfter(response, 30*time.Second))
+	}
+	return response, err
+}
+
+func envInt(name string, def int) int {
+	value, err := strconv.Atoi(os.Getenv(name))
+	if err != nil || value < 0 {
+		return def
+	}
+	return value
+}
+
+// initLimits reads worker counts and rates of the sites from the env
+func initLimits() {
+	zvukLimiter.setRate(envInt("ZVUKRPS", defaultZvukRps))
+	youtubeLimiter.setRate(envInt("YOURPS", defaultYouRps))
+
+	for siteId, env := range map[uint32]string{siteZvuk: "ZVUKWORKERS", siteYoutube: "YOUWORKERS"} {
+		p, err := ants.NewPool(max(envInt(env, defaultWorkers), 1))
+		if err != nil {
+			log.Printf("sync pool for site %v: %v", siteId, err)
+			continue
+		}
+		syncPools[siteId] = p
+	}
+}
+
+func releaseLimits(timeout time.Duration) {
+	for siteId, p := range syncPools {
+		if err := p.ReleaseTimeout(timeout); err != nil {
+			log.Printf("sync pool %v release : %v", siteId, err)
+		}
+	}
+}
+
+// submitSync runs the task on the pool of the site, or right here when there is none
+func submitSync(siteId uint32, task func()) {
+	p, ok := syncPools[siteId]
+	if !ok || p.Submit(task) != nil {
+		task()
+	}
+}
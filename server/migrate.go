@@ -0,0 +1,243 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+)
+
+// migrations bring a db.sqlite made by an older grpc-music-create.sql up to date, PRAGMA user_version keeps
+// how many of them are done. A db made by the current script starts at 0 as well, so every step must be safe to repeat.
+var migrations = []func(ctx context.Context, tx *sql.Tx) error{
+	migrateSearch,
+	migrateColumns,
+	migrateTables,
+}
+
+// addedColumns are the columns added to the tables of the first script
+var addedColumns = []struct {
+	table, column, def string
+}{
+	{"artist", "lastFullSync", "TEXT"},
+	{"channel", "lastFullSync", "TEXT"},
+	{"album", "removedAt", "TEXT"},
+	{"artistAlbum", "listed", "INTEGER default 0"},
+	{"video", "kind", "INTEGER DEFAULT 0 NOT NULL"},
+	{"artist", "skipSync", "INTEGER DEFAULT 0 NOT NULL"},
+	{"artist", "syncInterval", "INTEGER DEFAULT 0 NOT NULL"},
+	{"artist", "priority", "INTEGER DEFAULT 0 NOT NULL"},
+	{"artist", "lastSync", "TEXT"},
+	{"channel", "syncInterval", "INTEGER DEFAULT 0 NOT NULL"},
+	{"channel", "priority", "INTEGER DEFAULT 0 NOT NULL"},
+	{"channel", "lastSync", "TEXT"},
+}
+
+// addedTables are the tables and indexes that came after the first script, as they are in grpc-music-create.sql
+var addedTables = []string{
+	`CREATE TABLE IF NOT EXISTS track (
+    tr_id INTEGER PRIMARY KEY AUTOINCREMENT,
+    albumId INTEGER REFERENCES album (alb_id) ON DELETE CASCADE,
+    trackId TEXT NOT NULL,
+    position INTEGER default 0,
+    title TEXT,
+    duration INTEGER default 0,
+    genres TEXT,
+    hasFlac INTEGER default 0,
+    highestQuality TEXT,
+    explicit INTEGER default 0,
+    artistNames TEXT,
+    UNIQUE(albumId,trackId)
+);`,
+	`CREATE TABLE IF NOT EXISTS videoStats (
+    videoId INTEGER REFERENCES video (vid_id) ON DELETE CASCADE,
+    taken TEXT DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    viewCount INTEGER DEFAULT 0 NOT NULL,
+    likeCount INTEGER DEFAULT 0 NOT NULL,
+    commentCount INTEGER DEFAULT 0 NOT NULL
+);`,
+	`CREATE TABLE IF NOT EXISTS job (
+    job_id INTEGER PRIMARY KEY AUTOINCREMENT,
+    siteId INTEGER REFERENCES site (site_id) ON UPDATE CASCADE ON DELETE RESTRICT,
+    jobType INTEGER DEFAULT 0 NOT NULL,
+    state INTEGER DEFAULT 0 NOT NULL,
+    params TEXT,
+    progress INTEGER DEFAULT 0 NOT NULL,
+    total INTEGER DEFAULT 0 NOT NULL,
+    result TEXT,
+    error TEXT,
+    created TEXT DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    updated TEXT DEFAULT CURRENT_TIMESTAMP NOT NULL
+);`,
+	`CREATE TABLE IF NOT EXISTS youtubeQuota (
+    day TEXT NOT NULL,
+    method TEXT NOT NULL,
+    units INTEGER DEFAULT 0 NOT NULL,
+    calls INTEGER DEFAULT 0 NOT NULL,
+    UNIQUE(day,method)
+);`,
+	`CREATE TABLE IF NOT EXISTS syncRun (
+    run_id INTEGER PRIMARY KEY AUTOINCREMENT,
+    siteId INTEGER REFERENCES site (site_id) ON UPDATE CASCADE ON DELETE RESTRICT,
+    artistId TEXT NOT NULL,
+    triggeredBy INTEGER DEFAULT 0 NOT NULL,
+    state INTEGER DEFAULT 0 NOT NULL,
+    isAdd INTEGER DEFAULT 0 NOT NULL,
+    startedAt TEXT DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    finishedAt TEXT,
+    durationMs INTEGER DEFAULT 0 NOT NULL,
+    artistCount INTEGER DEFAULT 0 NOT NULL,
+    newAlbumCount INTEGER DEFAULT 0 NOT NULL,
+    removedAlbumCount INTEGER DEFAULT 0 NOT NULL,
+    newArtistCount INTEGER DEFAULT 0 NOT NULL,
+    failedCount INTEGER DEFAULT 0 NOT NULL,
+    error TEXT
+);`,
+	`CREATE TABLE IF NOT EXISTS syncRunItem (
+    runId INTEGER REFERENCES syncRun (run_id) ON DELETE CASCADE,
+    artistId TEXT NOT NULL,
+    title TEXT,
+    newAlbumCount INTEGER DEFAULT 0 NOT NULL,
+    removedAlbumCount INTEGER DEFAULT 0 NOT NULL,
+    newArtistCount INTEGER DEFAULT 0 NOT NULL,
+    error TEXT,
+    reason TEXT,
+    statusCode INTEGER DEFAULT 0 NOT NULL,
+    startedAt TEXT NOT NULL,
+    durationMs INTEGER DEFAULT 0 NOT NULL
+);`,
+	`CREATE TABLE IF NOT EXISTS downloadRule (
+    rule_id INTEGER PRIMARY KEY AUTOINCREMENT,
+    siteId INTEGER REFERENCES site (site_id) ON UPDATE CASCADE ON DELETE RESTRICT,
+    artistId TEXT DEFAULT '' NOT NULL,
+    releaseTypes TEXT,
+    kinds TEXT,
+    action INTEGER DEFAULT 0 NOT NULL,
+    quality TEXT,
+    enabled INTEGER DEFAULT 1 NOT NULL,
+    created TEXT DEFAULT CURRENT_TIMESTAMP NOT NULL
+);`,
+	"CREATE INDEX IF NOT EXISTS index_job_state ON job(state);",
+	"CREATE INDEX IF NOT EXISTS index_videoStats_videoId ON videoStats(videoId, taken);",
+	"CREATE INDEX IF NOT EXISTS index_syncRun_started ON syncRun(startedAt);",
+	"CREATE INDEX IF NOT EXISTS index_syncRunItem_runId ON syncRunItem(runId);",
+	"CREATE INDEX IF NOT EXISTS index_syncRunItem_artistId ON syncRunItem(artistId, runId);",
+	"CREATE INDEX IF NOT EXISTS index_downloadRule_site ON downloadRule(siteId, artistId);",
+}
+
+// searchTriggers keep the search table in step with the titles, rowid = id * 4 + kind: 0 artist, 1 album, 2 channel, 3 video
+var searchTriggers = []struct {
+	table, idCol string
+	kind         int
+}{
+	{"artist", "art_id", 0},
+	{"album", "alb_id", 1},
+	{"channel", "ch_id", 2},
+	{"video", "vid_id", 3},
+}
+
+// MigrateDb runs the migrations the db hasn't seen yet, each in its own transaction
+func MigrateDb(ctx context.Context) error {
+	db, err := sql.Open(sqlite3, fmt.Sprintf("file:%v?_foreign_keys=false&cache=shared&mode=rw", dbFile))
+	if err != nil {
+		log.Println(err)
+	}
+	defer func(db *sql.DB) {
+		err = db.Close()
+		if err != nil {
+			log.Println(err)
+		}
+	}(db)
+
+	var version int
+	if err = db.QueryRowContext(ctx, "PRAGMA user_version;").Scan(&version); err != nil {
+		return err
+	}
+
+	for i := version; i < len(migrations); i++ {
+		tx, er := db.BeginTx(ctx, nil)
+		if er != nil {
+			return er
+		}
+		if er = migrations[i](ctx, tx); er == nil {
+			// user_version пишется в той же транзакции, так что шаг либо сделан целиком, либо нет
+			_, er = tx.ExecContext(ctx, fmt.Sprintf("PRAGMA user_version = %d;", i+1))
+		}
+		if er != nil {
+			if err = tx.Rollback(); err != nil {
+				log.Println(err)
+			}
+			return fmt.Errorf("migration %d: %w", i+1, er)
+		}
+		if er = tx.Commit(); er != nil {
+			return er
+		}
+		fmt.Printf("db migrated to version %v\n", i+1)
+	}
+	return nil
+}
+
+// migrateSearch creates the search table with its triggers and indexes every title already in the db
+func migrateSearch(ctx context.Context, tx *sql.Tx) error {
+	_, err := tx.ExecContext(ctx, "CREATE VIRTUAL TABLE IF NOT EXISTS search USING fts5(title, tokenize = 'unicode61 remove_diacritics 2');")
+	if err != nil {
+		return err
+	}
+
+	for _, t := range searchTriggers {
+		rowId := fmt.Sprintf("%s * 4 + %d", t.idCol, t.kind)
+		for _, stmt := range []string{
+			fmt.Sprintf("CREATE TRIGGER IF NOT EXISTS search_%[1]s_insert AFTER INSERT ON %[1]s BEGIN INSERT INTO search(rowid, title) VALUES (new.%[2]s, new.title); END;", t.table, rowId),
+			fmt.Sprintf("CREATE TRIGGER IF NOT EXISTS search_%[1]s_update AFTER UPDATE OF title ON %[1]s BEGIN UPDATE search SET title = new.title WHERE rowid = old.%[2]s; END;", t.table, rowId),
+			fmt.Sprintf("CREATE TRIGGER IF NOT EXISTS search_%[1]s_delete AFTER DELETE ON %[1]s BEGIN DELETE FROM search WHERE rowid = old.%[2]s; END;", t.table, rowId),
+		} {
+			if _, err = tx.ExecContext(ctx, stmt); err != nil {
+				return err
+			}
+		}
+	}
+
+	// индекс собираем заново: в старой базе его нет, в новой он совпадет с тем, что сделали триггеры
+	if _, err = tx.ExecContext(ctx, "DELETE FROM search;"); err != nil {
+		return err
+	}
+	for _, t := range searchTriggers {
+		_, err = tx.ExecContext(ctx, fmt.Sprintf("INSERT INTO search(rowid, title) SELECT %s * 4 + %d, title FROM main.%s;", t.idCol, t.kind, t.table))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// migrateColumns adds the columns a db doesn't have yet, sqlite has no ADD COLUMN IF NOT EXISTS
+func migrateColumns(ctx context.Context, tx *sql.Tx) error {
+	for _, c := range addedColumns {
+		if existsTx(ctx, tx, "select 1 from pragma_table_info(?) where name = ?;", c.table, c.column) {
+			continue
+		}
+		if _, err := tx.ExecContext(ctx, fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s %s;", c.table, c.column, c.def)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func migrateTables(ctx context.Context, tx *sql.Tx) error {
+	for _, stmt := range addedTables {
+		if _, err := tx.ExecContext(ctx, stmt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func existsTx(ctx context.Context, tx *sql.Tx, query string, args ...interface{}) bool {
+	var one int
+	err := tx.QueryRowContext(ctx, query, args...).Scan(&one)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		log.Println(err)
+	}
+	return err == nil
+}
@@ -0,0 +1,77 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"testing"
+)
+
+func execAll(t *testing.T, stmts ...string) {
+	t.Helper()
+
+	db, err := sql.Open(sqlite3, fmt.Sprintf("file:%v?_foreign_keys=false&cache=shared&mode=rw", dbFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	for _, stmt := range stmts {
+		if _, err = db.Exec(stmt); err != nil {
+			t.Fatalf("%v: %v", stmt, err)
+		}
+	}
+}
+
+func TestMigrateDbFresh(t *testing.T) {
+	newTestDb(t)
+
+	for range 2 {
+		if err := MigrateDb(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestMigrateDbOld(t *testing.T) {
+	newTestDb(t)
+	// база старого скрипта: без новых колонок, таблиц и поиска
+	stmts := []string{"DROP TABLE search;", "DROP TABLE job;", "DROP TABLE downloadRule;"}
+	for _, tr := range searchTriggers {
+		for _, op := range []string{"insert", "update", "delete"} {
+			stmts = append(stmts, fmt.Sprintf("DROP TRIGGER search_%s_%s;", tr.table, op))
+		}
+	}
+	for _, c := range addedColumns {
+		stmts = append(stmts, fmt.Sprintf("ALTER TABLE %s DROP COLUMN %s;", c.table, c.column))
+	}
+	stmts = append(stmts,
+		"insert into main.artist(siteId, artistId, title) values (1, '100', 'Earth, Wind & Fire');",
+		"insert into main.album(albumId, title) values ('200', 'Remixes');",
+	)
+	execAll(t, stmts...)
+
+	ctx := context.Background()
+	if err := MigrateDb(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	if !existsDb(ctx, "select 1 from pragma_table_info('video') where name = 'kind';") {
+		t.Error("video.kind is not added")
+	}
+	if !existsDb(ctx, "select 1 from main.sqlite_master where name = 'downloadRule';") {
+		t.Error("downloadRule is not created")
+	}
+	for _, text := range []string{"wind", "remi"} {
+		res, err := SearchDb(ctx, text, 0, searchLimit, "", "")
+		if err != nil || len(res) != 1 {
+			t.Errorf("search %q of the old rows = %v, %v", text, res, err)
+		}
+	}
+
+	// триггеры работают и для новых строк
+	execAll(t, "insert into main.album(albumId, title) values ('201', 'Live at Wembley');")
+	if res, _ := SearchDb(ctx, "wembley", 0, searchLimit, "", ""); len(res) != 1 {
+		t.Errorf("search of a new row = %v", res)
+	}
+}
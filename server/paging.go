@@ -0,0 +1,196 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/v0vc/go-music-grpc/artist"
+)
+
+const (
+	sortDefault int32 = iota
+	sortDate
+	sortViews
+	sortLikes
+	sortQuality
+)
+
+const (
+	removedAll int32 = iota
+	removedHide
+	removedOnly
+)
+
+// sortColumns are the columns of the release queries of a site by sort key, the queries of a site share
+// their column numbers, a key missing here is not supported by the site
+var sortColumns = map[uint32]map[int32]string{
+	siteZvuk:    {sortDate: "4"},
+	siteYoutube: {sortDate: "5", sortViews: "7", sortLikes: "6", sortQuality: "12"},
+}
+
+// maxPageSize ограничивает страницу, чтобы не тянуть тысячи превью за раз
+const maxPageSize = 500
+
+// albumFilter is the paging, filtering and sorting part of ReadArtistAlbumRequest pushed down into sql
+type albumFilter struct {
+	pageSize     int
+	offset       int
+	releaseTypes []int32
+	dateFrom     string
+	dateTo       string
+	sortKey      int32
+	sortAsc      bool
+	removed      int32
+	kinds        []int32
+}
+
+func newAlbumFilter(req *artist.ReadArtistAlbumRequest) (*albumFilter, error) {
+	if req.GetPageSize() < 0 {
+		return nil, fmt.Errorf("bad page size: %v", req.GetPageSize())
+	}
+	if req.GetSortKey() < sortDefault || req.GetSortKey() > sortQuality {
+		return nil, fmt.Errorf("bad sort key: %v", req.GetSortKey())
+	}
+	if columns, ok := sortColumns[req.GetSiteId()]; ok && req.GetSortKey() != sortDefault {
+		if _, ok = columns[req.GetSortKey()]; !ok {
+			return nil, fmt.Errorf("sort key %v is not supported by site %v", req.GetSortKey(), req.GetSiteId())
+		}
+	}
+	if req.GetRemoved() < removedAll || req.GetRemoved() > removedOnly {
+		return nil, fmt.Errorf("bad removed filter: %v", req.GetRemoved())
+	}
+	for _, kind := range req.GetKinds() {
+		if kind < videoKindRegular || kind > videoKindPremiere {
+			return nil, fmt.Errorf("bad video kind: %v", kind)
+		}
+	}
+
+	f := &albumFilter{
+		pageSize:     min(int(req.GetPageSize()), maxPageSize),
+		releaseTypes: req.GetReleaseTypes(),
+		dateFrom:     req.GetDateFrom(),
+		dateTo:       req.GetDateTo(),
+		sortKey:      req.GetSortKey(),
+		sortAsc:      req.GetSortAsc(),
+		removed:      req.GetRemoved(),
+		kinds:        req.GetKinds(),
+	}
+
+	if token := req.GetPageToken(); token != "" {
+		offset, err := strconv.Atoi(token)
+		if err != nil || offset < 0 {
+			return nil, fmt.Errorf("bad page token: %v", token)
+		}
+		f.offset = offset
+	}
+
+	for _, date := range []string{f.dateFrom, f.dateTo} {
+		if date == "" {
+			continue
+		}
+		if _, err := time.Parse(time.DateOnly, date); err != nil {
+			return nil, fmt.Errorf("bad date: %v", date)
+		}
+	}
+
+	return f, nil
+}
+
+// where returns extra conditions for the date and release type columns, typeCol may be a constant like "3"
+func (f *albumFilter) where(dateCol, typeCol string) (string, []interface{}) {
+	var (
+		sb   strings.Builder
+		args []interface{}
+	)
+
+	if len(f.releaseTypes) > 0 {
+		sb.WriteString(fmt.Sprintf(" and %s in (?%s)", typeCol, strings.Repeat(",?", len(f.releaseTypes)-1)))
+		for _, t := range f.releaseTypes {
+			args = append(args, t)
+		}
+	}
+	if f.dateFrom != "" {
+		sb.WriteString(fmt.Sprintf(" and date(%s) >= date(?)", dateCol))
+		args = append(args, f.dateFrom)
+	}
+	if f.dateTo != "" {
+		sb.WriteString(fmt.Sprintf(" and date(%s) <= date(?)", dateCol))
+		args = append(args, f.dateTo)
+	}
+
+	return sb.String(), args
+}
+
+// whereRemoved filters by the removal mark, only zvuk keeps it
+func (f *albumFilter) whereRemoved(col string) string {
+	switch f.removed {
+	case removedHide:
+		return fmt.Sprintf(" and %s is null", col)
+	case removedOnly:
+		return fmt.Sprintf(" and %s is not null", col)
+	default:
+		return ""
+	}
+}
+
+// whereKinds filters videos by kind, only youtube has it
+func (f *albumFilter) whereKinds(col string) (string, []interface{}) {
+	if len(f.kinds) == 0 {
+		return "", nil
+	}
+
+	args := make([]interface{}, 0, len(f.kinds))
+	for _, kind := range f.kinds {
+		args = append(args, kind)
+	}
+	return fmt.Sprintf(" and %s in (?%s)", col, strings.Repeat(",?", len(f.kinds)-1)), args
+}
+
+// orderBy returns the order clause, the default key takes def, the others are checked by newAlbumFilter.
+// idCol goes last, so the pages stay stable when the values are equal.
+func (f *albumFilter) orderBy(def, idCol string, columns map[int32]string) string {
+	col, ok := columns[f.sortKey]
+	if !ok {
+		return fmt.Sprintf(" order by %s, %s desc", def, idCol)
+	}
+
+	dir := "desc"
+	if f.sortAsc {
+		dir = "asc"
+	}
+	return fmt.Sprintf(" order by %s %s, %s %s", col, dir, idCol, dir)
+}
+
+// limit asks for one row more than the page, so we know if there is a next one
+func (f *albumFilter) limit() string {
+	if f.pageSize == 0 && f.offset == 0 {
+		return ""
+	}
+
+	limit := -1
+	if f.pageSize > 0 {
+		limit = f.pageSize + 1
+	}
+	return fmt.Sprintf(" limit %d offset %d", limit, f.offset)
+}
+
+// isFirstPage is true when playlists and other page independent data should be returned
+func (f *albumFilter) isFirstPage() bool {
+	return f.offset == 0
+}
+
+// pastPage is true for the extra row asked by limit, it is counted but not scanned
+func (f *albumFilter) pastPage(scanned int) bool {
+	return f.pageSize > 0 && scanned > f.pageSize
+}
+
+// page returns the token of the next page. It looks at the rows read from sql, not at the rows kept,
+// so a row that failed to scan doesn't hide the next page.
+func page[T any](f *albumFilter, rows []T, scanned int) ([]T, string) {
+	if !f.pastPage(scanned) {
+		return rows, ""
+	}
+	return rows, strconv.Itoa(f.offset + f.pageSize)
+}
@@ -0,0 +1,29 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/v0vc/go-music-grpc/artist"
+)
+
+func TestNewAlbumFilterSortKey(t *testing.T) {
+	tests := []struct {
+		siteId  uint32
+		sortKey int32
+		ok      bool
+	}{
+		{siteZvuk, sortDefault, true},
+		{siteZvuk, sortDate, true},
+		{siteZvuk, sortViews, false},
+		{siteZvuk, sortQuality, false},
+		{siteYoutube, sortViews, true},
+		{siteYoutube, sortQuality, true},
+		{siteYoutube, sortQuality + 1, false},
+	}
+	for _, tt := range tests {
+		_, err := newAlbumFilter(&artist.ReadArtistAlbumRequest{SiteId: tt.siteId, SortKey: tt.sortKey})
+		if (err == nil) != tt.ok {
+			t.Errorf("site %v sort key %v: %v", tt.siteId, tt.sortKey, err)
+		}
+	}
+}
@@ -0,0 +1,259 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/v0vc/go-music-grpc/artist"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	siteZvuk    uint32 = 1
+	siteYoutube uint32 = 4
+)
+
+// Provider is a source of artists and releases, every site id has its own one.
+// A new source only needs an implementation and a line in providers.
+type Provider interface {
+	// ResolveId turns an id or a link pasted in the ui into the id the provider works with
+	ResolveId(raw string) (string, bool)
+	// ArtistIds returns the artist to sync, or all of them for "-1"
+	ArtistIds(ctx context.Context, artistId string) ([]ArtistRawId, error)
+	ArtistExists(ctx context.Context, artistId string) bool
+	// SyncArtist returns the synced artist, new co-artists and ids of artists that are gone
+	SyncArtist(ctx context.Context, artistId ArtistRawId, isAdd bool) (*artist.Artist, []*artist.Artist, []string, error)
+	ListArtists(ctx context.Context) ([]*artist.Artist, error)
+	DeleteArtist(ctx context.Context, artistId string) (int64, error)
+	ListReleases(ctx context.Context, artistId string, newOnly bool, f *albumFilter) ([]*artist.Album, []*artist.Playlist, string, error)
+	ReleaseIds(ctx context.Context, artistId string) ([]string, error)
+	Download(ctx context.Context, ids []string, quality string, isPl bool, progress DownloadProgress) (map[string]string, error)
+	ClearSync(ctx context.Context) (int64, error)
+	Acknowledge(ctx context.Context, ack *acknowledge) (int64, int64, error)
+	// ValidateToken makes the cheapest call to the api with the token
+	ValidateToken(ctx context.Context, token string) error
+}
+
+// planner is implemented by providers with a watch later list
+type planner interface {
+	SetPlanned(ctx context.Context, videoId string, state uint32) (int64, error)
+}
+
+// tracklister is implemented by providers that keep tracklists of releases
+type tracklister interface {
+	ReadTracks(ctx context.Context, albumId string) ([]*artist.Track, error)
+}
+
+var providers = map[uint32]Provider{
+	siteZvuk:    &zvukProvider{siteId: siteZvuk},
+	siteYoutube: &youtubeProvider{siteId: siteYoutube},
+}
+
+func getProvider(siteId uint32) (Provider, error) {
+	p, ok := providers[siteId]
+	if !ok {
+		// спотик и дизер пока только в планах
+		return nil, status.Errorf(codes.Unimplemented, "site %v is not supported", siteId)
+	}
+	return p, nil
+}
+
+var (
+	zvukIdRe    = regexp.MustCompile(`^\d+$`)
+	channelIdRe = regexp.MustCompile(`^UC[\w-]{22}$`)
+	handleRe    = regexp.MustCompile(`^@[\w.-]{3,30}$`)
+	videoIdRe   = regexp.MustCompile(`^[\w-]{11}$`)
+)
+
+// parseRef splits a pasted link into path segments, raw is returned as is when it is not a link
+func parseRef(raw string) (string, *url.URL, []string) {
+	ref := strings.Trim(strings.TrimSpace(raw), "\"")
+	u, err := url.Parse(ref)
+	if err != nil || u.Host == "" {
+		return ref, nil, nil
+	}
+	return ref, u, strings.Split(strings.Trim(u.Path, "/"), "/")
+}
+
+func existsDb(ctx context.Context, query string, args ...interface{}) bool {
+	db, err := sql.Open(sqlite3, fmt.Sprintf("file:%v?cache=shared&mode=ro", dbFile))
+	if err != nil {
+		log.Println(err)
+	}
+	defer func(db *sql.DB) {
+		err = db.Close()
+		if err != nil {
+			log.Println(err)
+		}
+	}(db)
+
+	var exist int
+	err = db.QueryRowContext(ctx, query, args...).Scan(&exist)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		log.Println(err)
+	}
+	return exist == 1
+}
+
+type zvukProvider struct {
+	siteId uint32
+}
+
+func (z *zvukProvider) ResolveId(raw string) (string, bool) {
+	ref, u, segments := parseRef(raw)
+	if u != nil {
+		// https://zvuk.com/artist/31873616
+		for i, seg := range segments {
+			if seg == "artist" && i+1 < len(segments) {
+				ref = segments[i+1]
+				break
+			}
+		}
+	}
+	return ref, zvukIdRe.MatchString(ref)
+}
+
+func (z *zvukProvider) ArtistIds(ctx context.Context, artistId string) ([]ArtistRawId, error) {
+	if artistId == "-1" {
+		return GetArtistIdsFromDb(ctx, z.siteId)
+	}
+	return []ArtistRawId{{Id: artistId}}, nil
+}
+
+func (z *zvukProvider) ArtistExists(ctx context.Context, artistId string) bool {
+	return existsDb(ctx, "select 1 from main.artist where siteId = ? and artistId = ? and userAdded = 1 limit 1;", z.siteId, artistId)
+}
+
+func (z *zvukProvider) SyncArtist(ctx context.Context, artistId ArtistRawId, isAdd bool) (*artist.Artist, []*artist.Artist, []string, error) {
+	return SyncArtist(ctx, z.siteId, artistId, isAdd)
+}
+
+func (z *zvukProvider) ListArtists(ctx context.Context) ([]*artist.Artist, error) {
+	return GetArtists(ctx, z.siteId)
+}
+
+func (z *zvukProvider) DeleteArtist(ctx context.Context, artistId string) (int64, error) {
+	return DeleteArtistsDb(ctx, z.siteId, []string{artistId}, true)
+}
+
+func (z *zvukProvider) ListReleases(ctx context.Context, artistId string, newOnly bool, f *albumFilter) ([]*artist.Album, []*artist.Playlist, string, error) {
+	var (
+		albums    []*artist.Album
+		nextToken string
+		err       error
+	)
+	if newOnly {
+		albums, nextToken, err = GetNewReleasesFromDb(ctx, z.siteId, f)
+	} else {
+		albums, nextToken, err = GetArtistReleasesFromDb(ctx, z.siteId, artistId, f)
+	}
+	return albums, nil, nextToken, err
+}
+
+func (z *zvukProvider) ReleaseIds(ctx context.Context, artistId string) ([]string, error) {
+	return GetArtistReleasesIdFromDb(ctx, z.siteId, artistId, false)
+}
+
+func (z *zvukProvider) Download(ctx context.Context, ids []string, quality string, _ bool, progress DownloadProgress) (map[string]string, error) {
+	// mid, high, flac
+	return DownloadAlbum(ctx, z.siteId, ids, quality, progress)
+}
+
+func (z *zvukProvider) ClearSync(ctx context.Context) (int64, error) {
+	return ClearAlbSyncStateDb(ctx, z.siteId)
+}
+
+func (z *zvukProvider) Acknowledge(ctx context.Context, ack *acknowledge) (int64, int64, error) {
+	return AckAlbSyncStateDb(ctx, z.siteId, ack)
+}
+
+func (z *zvukProvider) ValidateToken(ctx context.Context, token string) error {
+	return pingZvuk(ctx, token)
+}
+
+func (z *zvukProvider) ReadTracks(ctx context.Context, albumId string) ([]*artist.Track, error) {
+	return ReadAlbumTracks(ctx, z.siteId, albumId)
+}
+
+type youtubeProvider struct {
+	siteId uint32
+}
+
+func (y *youtubeProvider) ResolveId(raw string) (string, bool) {
+	ref, u, segments := parseRef(raw)
+	if u != nil {
+		switch {
+		case strings.HasSuffix(u.Host, "youtu.be"):
+			ref = segments[0]
+		case u.Query().Get("v") != "":
+			ref = u.Query().Get("v")
+		case strings.HasPrefix(segments[0], "@"):
+			ref = segments[0]
+		case segments[0] == "channel" && len(segments) > 1:
+			ref = segments[1]
+		}
+	}
+	return ref, channelIdRe.MatchString(ref) || handleRe.MatchString(ref) || videoIdRe.MatchString(ref)
+}
+
+func (y *youtubeProvider) ArtistIds(ctx context.Context, artistId string) ([]ArtistRawId, error) {
+	if artistId == "-1" {
+		return GetChannelIdsFromDb(ctx, y.siteId)
+	}
+	return []ArtistRawId{{Id: artistId, isPlSync: true}}, nil
+}
+
+func (y *youtubeProvider) ArtistExists(ctx context.Context, artistId string) bool {
+	return existsDb(ctx, "select 1 from main.channel where siteId = ? and channelId = ? limit 1;", y.siteId, artistId)
+}
+
+func (y *youtubeProvider) SyncArtist(ctx context.Context, artistId ArtistRawId, isAdd bool) (*artist.Artist, []*artist.Artist, []string, error) {
+	art, err := SyncArtistYou(ctx, y.siteId, artistId, isAdd)
+	return art, nil, nil, err
+}
+
+func (y *youtubeProvider) ListArtists(ctx context.Context) ([]*artist.Artist, error) {
+	return GetChannels(ctx, y.siteId)
+}
+
+func (y *youtubeProvider) DeleteArtist(ctx context.Context, artistId string) (int64, error) {
+	return DeleteChannelDb(ctx, y.siteId, []string{artistId})
+}
+
+func (y *youtubeProvider) ListReleases(ctx context.Context, artistId string, newOnly bool, f *albumFilter) ([]*artist.Album, []*artist.Playlist, string, error) {
+	if newOnly {
+		return GetNewVideosFromDb(ctx, f)
+	}
+	return GetChannelVideosFromDb(ctx, y.siteId, artistId, f)
+}
+
+func (y *youtubeProvider) ReleaseIds(ctx context.Context, artistId string) ([]string, error) {
+	return GetChannelVideosIdFromDb(ctx, y.siteId, artistId, false)
+}
+
+func (y *youtubeProvider) Download(ctx context.Context, ids []string, quality string, isPl bool, progress DownloadProgress) (map[string]string, error) {
+	return DownloadVideos(ctx, ids, quality, isPl, progress)
+}
+
+func (y *youtubeProvider) ClearSync(ctx context.Context) (int64, error) {
+	return ClearVidSyncStateDb(ctx, y.siteId)
+}
+
+func (y *youtubeProvider) Acknowledge(ctx context.Context, ack *acknowledge) (int64, int64, error) {
+	return AckVidSyncStateDb(ctx, y.siteId, ack)
+}
+
+func (y *youtubeProvider) ValidateToken(ctx context.Context, token string) error {
+	return pingYoutube(ctx, token)
+}
+
+func (y *youtubeProvider) SetPlanned(ctx context.Context, videoId string, state uint32) (int64, error) {
+	return SetPlannedDb(ctx, videoId, state)
+}
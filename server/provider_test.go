@@ -0,0 +1,177 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/v0vc/go-music-grpc/artist"
+)
+
+const siteFake uint32 = 99
+
+// newTestDb points dbFile to a new db made by the create script, with the real sites and the fake one
+func newTestDb(t *testing.T) {
+	t.Helper()
+
+	schema, err := os.ReadFile("../db/grpc-music-create.sql")
+	if err != nil {
+		t.Fatal(err)
+	}
+	file := dbFile
+	dbFile = filepath.Join(t.TempDir(), "db.sqlite")
+	t.Cleanup(func() {
+		dbFile = file
+	})
+
+	db, err := sql.Open(sqlite3, fmt.Sprintf("file:%v?cache=shared&mode=rwc", dbFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	if _, err = db.Exec(string(schema)); err != nil {
+		if strings.Contains(err.Error(), "no such module: fts5") {
+			// поиску нужен fts5, как и сборке в taskfile
+			t.Skip("run with -tags sqlite_fts5")
+		}
+		t.Fatal(err)
+	}
+	_, err = db.Exec("insert into main.site(site_id, title) values (?,'zvuk'),(?,'youtube'),(?,'fake');", siteZvuk, siteYoutube, siteFake)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+// fakeProvider serves artists from memory, an artist with an error in errs fails every sync
+type fakeProvider struct {
+	mu      sync.Mutex
+	artists map[string]*artist.Artist
+	errs    map[string][]error
+	added   map[string]bool
+	synced  []string
+}
+
+func newFakeProvider(t *testing.T, artists ...*artist.Artist) *fakeProvider {
+	t.Helper()
+
+	f := &fakeProvider{
+		artists: make(map[string]*artist.Artist),
+		errs:    make(map[string][]error),
+		added:   make(map[string]bool),
+	}
+	for _, art := range artists {
+		f.artists[art.GetArtistId()] = art
+	}
+
+	providers[siteFake] = f
+	t.Cleanup(func() {
+		delete(providers, siteFake)
+	})
+	return f
+}
+
+// failWith makes the next syncs of the artist fail with errs, one per call, the last one stays
+func (f *fakeProvider) failWith(artistId string, errs ...error) {
+	f.errs[artistId] = errs
+}
+
+func (f *fakeProvider) ResolveId(raw string) (string, bool) {
+	id := strings.TrimSpace(raw)
+	return id, strings.HasPrefix(id, "art")
+}
+
+func (f *fakeProvider) ArtistIds(_ context.Context, artistId string) ([]ArtistRawId, error) {
+	if artistId != "-1" {
+		return []ArtistRawId{{Id: artistId}}, nil
+	}
+
+	var ids []ArtistRawId
+	for id := range f.artists {
+		ids = append(ids, ArtistRawId{Id: id})
+	}
+	slices.SortFunc(ids, func(a, b ArtistRawId) int {
+		return strings.Compare(a.Id, b.Id)
+	})
+	return ids, nil
+}
+
+func (f *fakeProvider) ArtistExists(_ context.Context, artistId string) bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.added[artistId]
+}
+
+func (f *fakeProvider) SyncArtist(_ context.Context, artistId ArtistRawId, isAdd bool) (*artist.Artist, []*artist.Artist, []string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.synced = append(f.synced, artistId.Id)
+	if errs := f.errs[artistId.Id]; len(errs) > 0 {
+		err := errs[0]
+		if len(errs) > 1 {
+			f.errs[artistId.Id] = errs[1:]
+		}
+		if err != nil {
+			return nil, nil, nil, err
+		}
+	}
+
+	art, ok := f.artists[artistId.Id]
+	if !ok {
+		return nil, nil, nil, nil
+	}
+	if isAdd {
+		f.added[artistId.Id] = true
+	}
+	return art, nil, nil, nil
+}
+
+func (f *fakeProvider) ListArtists(context.Context) ([]*artist.Artist, error) {
+	return nil, nil
+}
+
+func (f *fakeProvider) DeleteArtist(context.Context, string) (int64, error) {
+	return 0, nil
+}
+
+func (f *fakeProvider) ListReleases(context.Context, string, bool, *albumFilter) ([]*artist.Album, []*artist.Playlist, string, error) {
+	return nil, nil, "", nil
+}
+
+func (f *fakeProvider) ReleaseIds(context.Context, string) ([]string, error) {
+	return nil, nil
+}
+
+func (f *fakeProvider) Download(context.Context, []string, string, bool, DownloadProgress) (map[string]string, error) {
+	return nil, nil
+}
+
+func (f *fakeProvider) ClearSync(context.Context) (int64, error) {
+	return 0, nil
+}
+
+func (f *fakeProvider) Acknowledge(context.Context, *acknowledge) (int64, int64, error) {
+	return 0, 0, nil
+}
+
+func (f *fakeProvider) ValidateToken(context.Context, string) error {
+	return nil
+}
+
+func TestGetProvider(t *testing.T) {
+	newFakeProvider(t)
+
+	if p, err := getProvider(siteFake); err != nil || p == nil {
+		t.Fatalf("getProvider(%v) = %v, %v", siteFake, p, err)
+	}
+	if _, err := getProvider(2); err == nil {
+		t.Fatal("getProvider(2) wants an error")
+	}
+}
@@ -0,0 +1,294 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"math/rand/v2"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/v0vc/go-music-grpc/artist"
+)
+
+const (
+	defaultSyncJitterSec = 300
+	// чаще нет смысла, да и сайты не обрадуются
+	minScheduleEvery = 10 * time.Minute
+)
+
+// scheduleEnvs is the prefix of the schedule settings of a site: ZVUKSCHEDULE, ZVUKJITTER and so on
+var scheduleEnvs = map[uint32]string{siteZvuk: "ZVUK", siteYoutube: "YOU"}
+
+// schedules is filled once before the server starts serving, later only the schedules themselves change
+var schedules = make(map[uint32]*siteSchedule)
+
+// quietHours is a window of local hours without scheduled syncs, to is not included and may be past midnight
+type quietHours struct {
+	from, to int
+}
+
+func parseQuietHours(raw string) (*quietHours, error) {
+	var q quietHours
+	if _, err := fmt.Sscanf(raw, "%d-%d", &q.from, &q.to); err != nil {
+		return nil, fmt.Errorf("bad quiet hours: %v", raw)
+	}
+	if q.from < 0 || q.from > 23 || q.to < 0 || q.to > 23 || q.from == q.to {
+		return nil, fmt.Errorf("bad quiet hours: %v", raw)
+	}
+	return &q, nil
+}
+
+func (q *quietHours) contains(t time.Time) bool {
+	h := t.Hour()
+	if q.from < q.to {
+		return h >= q.from && h < q.to
+	}
+	return h >= q.from || h < q.to
+}
+
+// end returns the first minute after the window that contains t
+func (q *quietHours) end(t time.Time) time.Time {
+	end := time.Date(t.Year(), t.Month(), t.Day(), q.to, 0, 0, 0, t.Location())
+	if !end.After(t) {
+		end = end.AddDate(0, 0, 1)
+	}
+	return end
+}
+
+func (q *quietHours) String() string {
+	if q == nil {
+		return ""
+	}
+	return fmt.Sprintf("%d-%d", q.from, q.to)
+}
+
+// siteSchedule runs the sync of all artists of a site by a cron expression or every interval,
+// plus a random jitter in the spirit of RandomPause, and moves runs out of the quiet hours
+type siteSchedule struct {
+	siteId uint32
+	spec   string
+	cron   *cronSpec
+	every  time.Duration
+	jitter time.Duration
+	quiet  *quietHours
+
+	mu        sync.Mutex
+	next      time.Time
+	lastRun   time.Time
+	lastRunId int64
+	running   bool
+	skipped   int32
+}
+
+// newSiteSchedule takes a cron expression like "0 */6 * * *" or an interval like "6h" or "@every 6h"
+func newSiteSchedule(siteId uint32, spec string, jitterSec int, quiet string) (*siteSchedule, error) {
+	s := &siteSchedule{
+		siteId: siteId,
+		spec:   strings.TrimSpace(spec),
+		jitter: time.Duration(jitterSec) * time.Second,
+	}
+
+	if every, err := time.ParseDuration(strings.TrimSpace(strings.TrimPrefix(s.spec, "@every"))); err == nil {
+		if every < minScheduleEvery {
+			return nil, fmt.Errorf("sync interval %v is shorter than %v", every, minScheduleEvery)
+		}
+		s.every = every
+	} else {
+		c, er := parseCron(s.spec)
+		if er != nil {
+			return nil, er
+		}
+		s.cron = c
+	}
+
+	if quiet != "" {
+		q, err := parseQuietHours(quiet)
+		if err != nil {
+			return nil, err
+		}
+		s.quiet = q
+	}
+	return s, nil
+}
+
+func (s *siteSchedule) addJitter(t time.Time) time.Time {
+	if s.jitter <= 0 {
+		return t
+	}
+	return t.Add(rand.N(s.jitter))
+}
+
+// plan returns the next run after now, an interval is counted from the last run, zero time when the cron never matches
+func (s *siteSchedule) plan(now, last time.Time) time.Time {
+	var next time.Time
+	if s.cron != nil {
+		next = s.cron.next(now)
+		if next.IsZero() {
+			return next
+		}
+	} else {
+		next = last.Add(s.every)
+		if last.IsZero() || next.Before(now) {
+			// пропустили, пока сервер лежал, идем сразу
+			next = now
+		}
+	}
+
+	next = s.addJitter(next)
+	if s.quiet != nil && s.quiet.contains(next) {
+		next = s.addJitter(s.quiet.end(next))
+	}
+	return next
+}
+
+func (s *siteSchedule) loop() {
+	last := lastScheduledRunDb(context.Background(), s.siteId)
+	for {
+		next := s.plan(time.Now(), last)
+		if next.IsZero() {
+			log.Printf("siteId: %v, schedule %q never fires", s.siteId, s.spec)
+			return
+		}
+		s.mu.Lock()
+		s.next = next
+		s.mu.Unlock()
+		fmt.Printf("siteId: %v, next scheduled sync at %v\n", s.siteId, next.Format(time.DateTime))
+
+		timer := time.NewTimer(time.Until(next))
+		<-timer.C
+		last = time.Now()
+		s.fire()
+	}
+}
+
+// fire starts the sync of all artists unless the previous one, scheduled or started by hand, is still going
+func (s *siteSchedule) fire() {
+	s.mu.Lock()
+	busy := s.running
+	s.mu.Unlock()
+	if busy || fullSyncRunning(s.siteId) {
+		s.mu.Lock()
+		s.skipped++
+		s.mu.Unlock()
+		fmt.Printf("siteId: %v, previous sync is still going, scheduled run skipped\n", s.siteId)
+		return
+	}
+
+	s.mu.Lock()
+	s.running = true
+	s.lastRun = time.Now()
+	s.mu.Unlock()
+
+	go func() {
+		var summary *artist.SyncArtistSummary
+		_, err := syncArtists(context.Background(), &artist.SyncArtistRequest{SiteId: s.siteId, ArtistId: "-1"}, syncTriggerSchedule, func(event *artist.SyncArtistEvent) {
+			if event.GetEventType() == syncEventSummary {
+				summary = event.GetSummary()
+			}
+		})
+		if err != nil {
+			log.Printf("Scheduled sync error: %v", err)
+		} else {
+			fmt.Printf("siteId: %v, scheduled sync completed, new : %v\n", s.siteId, summary.GetNewAlbumCount())
+		}
+
+		s.mu.Lock()
+		s.running = false
+		s.lastRunId = summary.GetRunId()
+		s.mu.Unlock()
+	}()
+}
+
+func (s *siteSchedule) snapshot() *artist.SyncSchedule {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	res := &artist.SyncSchedule{
+		SiteId:       s.siteId,
+		Spec:         s.spec,
+		JitterSec:    int32(s.jitter.Seconds()),
+		QuietHours:   s.quiet.String(),
+		Running:      s.running,
+		LastRunId:    s.lastRunId,
+		SkippedCount: s.skipped,
+	}
+	if !s.next.IsZero() {
+		res.NextRun = s.next.Format(time.DateTime)
+		res.NextRunIn = TimeAgo(s.next)
+	}
+	if !s.lastRun.IsZero() {
+		res.LastRun = s.lastRun.Format(time.DateTime)
+	}
+	return res
+}
+
+// lastScheduledRunDb is the start of the last scheduled run, so a restart doesn't push the interval further
+func lastScheduledRunDb(ctx context.Context, siteId uint32) time.Time {
+	db, err := sql.Open(sqlite3, fmt.Sprintf("file:%v?cache=shared&mode=ro", dbFile))
+	if err != nil {
+		log.Println(err)
+	}
+	defer func(db *sql.DB) {
+		err = db.Close()
+		if err != nil {
+			log.Println(err)
+		}
+	}(db)
+
+	var started sql.NullString
+	err = db.QueryRowContext(ctx, "select max(startedAt) from main.syncRun where siteId = ? and triggeredBy = ?;", siteId, syncTriggerSchedule).Scan(&started)
+	if err != nil {
+		log.Println(err)
+	}
+	if !started.Valid {
+		return time.Time{}
+	}
+
+	last, err := time.ParseInLocation(time.DateTime, started.String, time.UTC)
+	if err != nil {
+		log.Println(err)
+	}
+	return last
+}
+
+// startSchedules reads ZVUKSCHEDULE, YOUSCHEDULE, their JITTER in seconds and QUIETHOURS like 1-7 and starts the loops
+func startSchedules() {
+	quiet := os.Getenv("QUIETHOURS")
+	for siteId, prefix := range scheduleEnvs {
+		spec := os.Getenv(prefix + "SCHEDULE")
+		if spec == "" {
+			continue
+		}
+		s, err := newSiteSchedule(siteId, spec, envInt(prefix+"JITTER", defaultSyncJitterSec), quiet)
+		if err != nil {
+			log.Printf("siteId: %v, schedule: %v", siteId, err)
+			continue
+		}
+		schedules[siteId] = s
+		go s.loop()
+	}
+}
+
+// GetSchedules returns the schedule of the site or of all sites, a site without one has only its id set
+func GetSchedules(siteId uint32) []*artist.SyncSchedule {
+	var res []*artist.SyncSchedule
+	for id := range scheduleEnvs {
+		if siteId != 0 && id != siteId {
+			continue
+		}
+		if s, ok := schedules[id]; ok {
+			res = append(res, s.snapshot())
+		} else {
+			res = append(res, &artist.SyncSchedule{SiteId: id})
+		}
+	}
+	slices.SortFunc(res, func(a, b *artist.SyncSchedule) int {
+		return int(a.GetSiteId()) - int(b.GetSiteId())
+	})
+	return res
+}
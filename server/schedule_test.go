@@ -0,0 +1,47 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func TestQuietHours(t *testing.T) {
+	hour := func(h, m int) time.Time {
+		return time.Date(2026, 5, 1, h, m, 0, 0, time.UTC)
+	}
+	tests := []struct {
+		quiet    string
+		at       time.Time
+		contains bool
+		end      time.Time
+	}{
+		{"1-7", hour(0, 59), false, time.Time{}},
+		{"1-7", hour(1, 0), true, hour(7, 0)},
+		{"1-7", hour(6, 59), true, hour(7, 0)},
+		{"1-7", hour(7, 0), false, time.Time{}},
+		// окно через полночь
+		{"23-6", hour(23, 30), true, time.Date(2026, 5, 2, 6, 0, 0, 0, time.UTC)},
+		{"23-6", hour(2, 0), true, hour(6, 0)},
+		{"23-6", hour(12, 0), false, time.Time{}},
+	}
+	for _, tt := range tests {
+		q, err := parseQuietHours(tt.quiet)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := q.contains(tt.at); got != tt.contains {
+			t.Errorf("%v contains %v = %v, want %v", tt.quiet, tt.at.Format(time.TimeOnly), got, tt.contains)
+		}
+		if tt.contains {
+			if got := q.end(tt.at); !got.Equal(tt.end) {
+				t.Errorf("%v end after %v = %v, want %v", tt.quiet, tt.at.Format(time.DateTime), got, tt.end)
+			}
+		}
+	}
+
+	for _, raw := range []string{"", "7", "5-5", "24-1", "1-24", "-1-5"} {
+		if _, err := parseQuietHours(raw); err == nil {
+			t.Errorf("parseQuietHours(%q) wants an error", raw)
+		}
+	}
+}
@@ -0,0 +1,122 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"strings"
+	"unicode"
+
+	"github.com/v0vc/go-music-grpc/artist"
+)
+
+const (
+	searchLimit    = 50
+	searchMaxLimit = 200
+)
+
+// ftsQuery turns the user text into a fts5 query: every word is quoted, so the fts syntax can't break it,
+// and matched by prefix, so "remi" finds "remix"
+func ftsQuery(text string) string {
+	words := strings.FieldsFunc(text, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+
+	var sb strings.Builder
+	for _, word := range words {
+		sb.WriteString(`"` + word + `"* `)
+	}
+
+	return strings.TrimSpace(sb.String())
+}
+
+func SearchDb(ctx context.Context, text string, siteId uint32, limit int32, markStart, markEnd string) ([]*artist.SearchResult, error) {
+	db, err := sql.Open(sqlite3, fmt.Sprintf("file:%v?cache=shared&mode=ro", dbFile))
+	if err != nil {
+		log.Println(err)
+	}
+	defer func(db *sql.DB) {
+		err = db.Close()
+		if err != nil {
+			log.Println(err)
+		}
+	}(db)
+
+	query := ftsQuery(text)
+	if query == "" {
+		return nil, nil
+	}
+
+	if limit <= 0 {
+		limit = searchLimit
+	}
+	limit = min(limit, searchMaxLimit)
+
+	// rowid поиска = id * 4 + вид строки, см. триггеры search_*
+	stRows, err := db.PrepareContext(ctx, `select search.rowid % 4 as kind,
+       ifnull(coalesce(ar.siteId, alAr.siteId, ch.siteId, vCh.siteId), 0) as siteId,
+       coalesce(ar.artistId, al.albumId, ch.channelId, v.videoId) as id,
+       search.title,
+       highlight(search, 0, ?, ?),
+       coalesce(alAr.artistId, vCh.channelId, '') as parentId,
+       coalesce(alAr.title, vCh.title, '') as parentTitle,
+       coalesce(al.releaseDate, v.timestamp, '') as releaseDate,
+       -search.rank
+from search
+         left join main.artist ar on search.rowid % 4 = 0 and ar.art_id = search.rowid / 4
+         left join main.album al on search.rowid % 4 = 1 and al.alb_id = search.rowid / 4
+         left join main.artist alAr on alAr.art_id = (select aa.artistId from main.artistAlbum aa where aa.albumId = al.alb_id limit 1)
+         left join main.channel ch on search.rowid % 4 = 2 and ch.ch_id = search.rowid / 4
+         left join main.video v on search.rowid % 4 = 3 and v.vid_id = search.rowid / 4
+         left join main.channel vCh on vCh.ch_id = (select cp.channelId from main.playlistVideo pv join main.channelPlaylist cp on cp.playlistId = pv.playlistId where pv.videoId = v.vid_id limit 1)
+where search match ?
+  and (? = 0 or coalesce(ar.siteId, alAr.siteId, ch.siteId, vCh.siteId) = ?)
+order by search.rank
+limit ?;`)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	defer func(stRows *sql.Stmt) {
+		err = stRows.Close()
+		if err != nil {
+			log.Println(err)
+		}
+	}(stRows)
+
+	if markStart == "" && markEnd == "" {
+		markStart, markEnd = "[", "]"
+	}
+
+	rows, err := stRows.QueryContext(ctx, markStart, markEnd, query, siteId, siteId, limit)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	defer func(rows *sql.Rows) {
+		err = rows.Close()
+		if err != nil {
+			log.Println(err)
+		}
+	}(rows)
+
+	var res []*artist.SearchResult
+
+	for rows.Next() {
+		var (
+			item artist.SearchResult
+			id   sql.NullString
+		)
+
+		if err = rows.Scan(&item.Kind, &item.SiteId, &id, &item.Title, &item.Snippet, &item.ParentId, &item.ParentTitle, &item.ReleaseDate, &item.Score); err != nil {
+			log.Println(err)
+		} else if id.Valid {
+			// строки без источника (индекс разошелся с таблицами) пропускаем
+			item.Id = id.String
+			res = append(res, &item)
+		}
+	}
+
+	return res, rows.Err()
+}
@@ -15,8 +15,6 @@ import (
 	"sync"
 	"time"
 
-	slices2 "golang.org/x/exp/slices"
-
 	"github.com/joho/godotenv"
 	_ "github.com/mattn/go-sqlite3"
 	"github.com/panjf2000/ants/v2"
@@ -27,17 +25,21 @@ import (
 )
 
 const (
-	defaultPort      = "50005"
-	defaultInterface = "0.0.0.0"
-	dbFile           = "./db.sqlite"
-	sqlite3          = "sqlite3"
+	defaultPort         = "50005"
+	defaultInterface    = "0.0.0.0"
+	sqlite3             = "sqlite3"
+	defaultFullSyncDays = 7
 )
 
 var (
 	YouDir  string
 	ZvukDir string
-	wgSync  sync.WaitGroup
-	pool    *ants.MultiPool
+	// dbFile не константа, чтобы тесты не трогали рабочую базу
+	dbFile = "./db.sqlite"
+	// между полными синками читаем только свежие страницы дискографии
+	FullSyncDays = defaultFullSyncDays
+	wgSync       sync.WaitGroup
+	pool         *ants.MultiPool
 )
 
 type server struct {
@@ -51,6 +53,28 @@ type ArtistRawId struct {
 	vidIds         []string
 }
 
+// acknowledge выбирает, у чего сбросить признак новизны: у релизов по id, у всего артиста или у вышедшего до даты
+type acknowledge struct {
+	ids      []string
+	artistId string
+	before   string
+}
+
+func (a *acknowledge) where(idCol, artistCol, dateCol string) (string, []interface{}) {
+	switch {
+	case len(a.ids) > 0:
+		args := make([]interface{}, len(a.ids))
+		for i, id := range a.ids {
+			args[i] = id
+		}
+		return fmt.Sprintf(" and %s in (?%s)", idCol, strings.Repeat(",?", len(a.ids)-1)), args
+	case a.artistId != "":
+		return fmt.Sprintf(" and %s = ?", artistCol), []interface{}{a.artistId}
+	default:
+		return fmt.Sprintf(" and date(%s) < date(?)", dateCol), []interface{}{a.before}
+	}
+}
+
 func GetTokenOnlyDbWoTx(ctx context.Context, siteId uint32) string {
 	db, err := sql.Open(sqlite3, fmt.Sprintf("file:%v?_foreign_keys=false&cache=shared&mode=rw", dbFile))
 	if err != nil {
@@ -116,6 +140,51 @@ func GetTokenOnlyDb(tx *sql.Tx, ctx context.Context, siteId uint32) string {
 	return token.String
 }
 
+// GetCredentialsDb returns login and pass of the site for the token renewal
+func GetCredentialsDb(ctx context.Context, siteId uint32) (string, string, error) {
+	db, err := sql.Open(sqlite3, fmt.Sprintf("file:%v?cache=shared&mode=ro", dbFile))
+	if err != nil {
+		log.Println(err)
+	}
+	defer func(db *sql.DB) {
+		err = db.Close()
+		if err != nil {
+			log.Println(err)
+		}
+	}(db)
+
+	var login, pass sql.NullString
+	err = db.QueryRowContext(ctx, "select login, pass from main.site where site_id = ? limit 1;", siteId).Scan(&login, &pass)
+	if err != nil {
+		log.Println(err)
+	}
+
+	return login.String, pass.String, err
+}
+
+func UpdateTokenDb(ctx context.Context, siteId uint32, token string) error {
+	txMu.Lock()
+	defer txMu.Unlock()
+
+	db, err := sql.Open(sqlite3, fmt.Sprintf("file:%v?_foreign_keys=false&cache=shared&mode=rw", dbFile))
+	if err != nil {
+		log.Println(err)
+	}
+	defer func(db *sql.DB) {
+		err = db.Close()
+		if err != nil {
+			log.Println(err)
+		}
+	}(db)
+
+	_, err = db.ExecContext(ctx, "update main.site set token = ? where site_id = ?;", token, siteId)
+	if err != nil {
+		log.Println(err)
+	}
+
+	return err
+}
+
 func GetThumb(ctx context.Context, url string) []byte {
 	// rkn block fix
 	if strings.Contains(url, "yt3.ggpht.com") {
@@ -126,7 +195,7 @@ func GetThumb(ctx context.Context, url string) []byte {
 		return nil
 	}
 
-	response, err := http.DefaultClient.Do(req)
+	response, err := mediaClient.Do(req)
 
 	if err != nil || response == nil {
 		return nil
@@ -181,67 +250,22 @@ func (*server) SyncArtist(ctx context.Context, req *artist.SyncArtistRequest) (*
 	fmt.Printf("siteId: %v, sync artist: %v started\n", siteId, artistId)
 
 	var (
-		artists    []*artist.Artist
-		artIds     []ArtistRawId
-		deletedIds []string
-		err        error
+		artists []*artist.Artist
+		summary *artist.SyncArtistSummary
+		err     error
 	)
 
-	var deletedArtIds []string
-	wgSync.Add(1)
-	var art *artist.Artist
-	_ = pool.Submit(func() {
-		switch siteId {
-		case 1:
-			// автор со сберзвука
-			if artistId == "-1" {
-				artIds, err = GetArtistIdsFromDb(ctx, siteId)
-			} else {
-				artIds = append(artIds, ArtistRawId{Id: artistId})
-			}
-			for _, artId := range artIds {
-				art, deletedArtIds, err = SyncArtist(context.WithoutCancel(ctx), siteId, artId, req.GetIsAdd())
-				for _, id := range deletedArtIds {
-					if !slices2.Contains(deletedIds, id) {
-						deletedIds = append(deletedIds, id)
-					}
-				}
-				if err != nil {
-					log.Printf("Sync error: %v", err)
-				} else {
-					artists = append(artists, art)
-				}
-			}
-		case 2:
-			// автор со спотика
-		case 3:
-			// автор с дизера
-		case 4:
-			// автор с ютуба
-			if artistId == "-1" {
-				artIds, err = GetChannelIdsFromDb(ctx, siteId)
-			} else {
-				artIds = append(artIds, ArtistRawId{Id: artistId, isPlSync: true})
-			}
-
-			for _, artId := range artIds {
-				art, err = SyncArtistYou(context.WithoutCancel(ctx), siteId, artId, req.GetIsAdd())
-				if err != nil {
-					log.Printf("Sync error: %v", err)
-				} else {
-					artists = append(artists, art)
-				}
-			}
+	// артисты сами раскладываются по пулу сайта
+	artists, err = syncArtists(ctx, req, syncTriggerRpc, func(event *artist.SyncArtistEvent) {
+		if event.GetEventType() == syncEventSummary {
+			summary = event.GetSummary()
 		}
-		wgSync.Done()
 	})
 
-	wgSync.Wait()
-
 	// post actions
-	/*if siteId == 1 && deletedIds != nil {
-		fmt.Printf("unused artists: %v\n", deletedIds)
-		deletedRowCount, er := DeleteArtistsDb(context.WithoutCancel(ctx), siteId, deletedIds, false)
+	/*if siteId == 1 && summary.GetDeletedArtistIds() != nil {
+		fmt.Printf("unused artists: %v\n", summary.GetDeletedArtistIds())
+		deletedRowCount, er := DeleteArtistsDb(context.WithoutCancel(ctx), siteId, summary.GetDeletedArtistIds(), false)
 		if er != nil {
 			log.Printf("delete unused artists failed: %v", er)
 		} else {
@@ -250,21 +274,10 @@ func (*server) SyncArtist(ctx context.Context, req *artist.SyncArtistRequest) (*
 	}*/
 
 	if err != nil {
-		return nil, status.Errorf(
-			codes.Internal,
-			"Internal error",
-		)
+		log.Printf("Sync error: %v", err)
+		return nil, toStatus(err)
 	} else {
-		var resCount int
-		for _, ar := range artists {
-			if ar == nil || ar.Albums == nil {
-				continue
-			}
-			for range ar.Albums {
-				resCount++
-			}
-		}
-		fmt.Printf("siteId: %v, sync: %v completed, new : %v\n", siteId, artistId, resCount)
+		fmt.Printf("siteId: %v, sync: %v completed, new : %v\n", siteId, artistId, summary.GetNewAlbumCount())
 	}
 
 	return &artist.SyncArtistResponse{
@@ -272,6 +285,42 @@ func (*server) SyncArtist(ctx context.Context, req *artist.SyncArtistRequest) (*
 	}, nil
 }
 
+func (*server) SyncArtistStream(req *artist.SyncArtistRequest, stream artist.ArtistService_SyncArtistStreamServer) error {
+	siteId := req.GetSiteId()
+	artistId := req.GetArtistId()
+	fmt.Printf("siteId: %v, sync stream: %v started\n", siteId, artistId)
+
+	var (
+		summary *artist.SyncArtistSummary
+		sendErr error
+		err     error
+	)
+
+	// артисты сами раскладываются по пулу сайта
+	_, err = syncArtists(stream.Context(), req, syncTriggerStream, func(event *artist.SyncArtistEvent) {
+		if event.GetEventType() == syncEventSummary {
+			summary = event.GetSummary()
+		}
+		if sendErr != nil {
+			// клиент отвалился, досинкаем молча
+			return
+		}
+		sendErr = stream.Send(event)
+		if sendErr != nil {
+			log.Printf("Sync stream send error: %v", sendErr)
+		}
+	})
+
+	if err != nil {
+		log.Printf("Sync error: %v", err)
+		return toStatus(err)
+	} else {
+		fmt.Printf("siteId: %v, sync stream: %v completed, new : %v\n", siteId, artistId, summary.GetNewAlbumCount())
+	}
+
+	return sendErr
+}
+
 func (*server) ReadArtistAlbums(ctx context.Context, req *artist.ReadArtistAlbumRequest) (*artist.ReadArtistAlbumResponse, error) {
 	siteId := req.GetSiteId()
 	artistId := req.GetArtistId()
@@ -281,39 +330,20 @@ func (*server) ReadArtistAlbums(ctx context.Context, req *artist.ReadArtistAlbum
 		fmt.Printf("siteId: %v, read items: %v started\n", siteId, artistId)
 	}
 
-	var (
-		albums    []*artist.Album
-		playlists []*artist.Playlist
-		err       error
-	)
+	f, err := newAlbumFilter(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 
-	switch siteId {
-	case 1:
-		// автор со сберзвука
-		if req.GetNewOnly() {
-			albums, err = GetNewReleasesFromDb(context.WithoutCancel(ctx), siteId)
-		} else {
-			albums, err = GetArtistReleasesFromDb(context.WithoutCancel(ctx), siteId, artistId)
-		}
-	case 2:
-		// автор со спотика
-	case 3:
-		// автор с дизера
-	case 4:
-		// автор с ютуба
-		if req.GetNewOnly() {
-			albums, playlists, err = GetNewVideosFromDb(context.WithoutCancel(ctx))
-		} else {
-			albums, playlists, err = GetChannelVideosFromDb(context.WithoutCancel(ctx), siteId, artistId)
-		}
+	p, err := getProvider(siteId)
+	if err != nil {
+		return nil, err
 	}
 
+	albums, playlists, nextToken, err := p.ListReleases(context.WithoutCancel(ctx), artistId, req.GetNewOnly(), f)
 	if err != nil {
 		log.Printf("Read error: %v", err)
-		return nil, status.Errorf(
-			codes.Internal,
-			"Internal error",
-		)
+		return nil, toStatus(err)
 	} else {
 		if artistId == "" {
 			fmt.Printf("siteId: %v, read new items completed, total: %v\n", siteId, len(albums))
@@ -323,45 +353,63 @@ func (*server) ReadArtistAlbums(ctx context.Context, req *artist.ReadArtistAlbum
 	}
 
 	return &artist.ReadArtistAlbumResponse{
-		Releases:  albums,
-		Playlists: playlists,
+		Releases:      albums,
+		Playlists:     playlists,
+		NextPageToken: nextToken,
 	}, err
 }
 
+func (*server) ReadAlbumTracks(ctx context.Context, req *artist.ReadAlbumTracksRequest) (*artist.ReadAlbumTracksResponse, error) {
+	siteId := req.GetSiteId()
+	albumId := req.GetAlbumId()
+	if albumId == "" {
+		return nil, status.Error(codes.InvalidArgument, "album id is empty")
+	}
+	fmt.Printf("siteId: %v, read tracks: %v started\n", siteId, albumId)
+
+	p, err := getProvider(siteId)
+	if err != nil {
+		return nil, err
+	}
+	tl, ok := p.(tracklister)
+	if !ok {
+		return nil, status.Errorf(codes.Unimplemented, "site %v has no tracks", siteId)
+	}
+
+	tracks, err := tl.ReadTracks(context.WithoutCancel(ctx), albumId)
+	if err != nil {
+		log.Printf("Read tracks error: %v", err)
+		return nil, toStatus(err)
+	}
+	fmt.Printf("siteId: %v, read tracks: %v completed, total: %v\n", siteId, albumId, len(tracks))
+
+	return &artist.ReadAlbumTracksResponse{Tracks: tracks}, nil
+}
+
 func (*server) DeleteArtist(ctx context.Context, req *artist.DeleteArtistRequest) (*artist.DeleteArtistResponse, error) {
 	siteId := req.GetSiteId()
 	artistId := req.GetArtistId()
 	fmt.Printf("siteId: %v, deleting artist %v started\n", siteId, artistId)
 
-	var (
-		res int64
-		err error
-	)
+	p, err := getProvider(siteId)
+	if err != nil {
+		return nil, err
+	}
+
+	var res int64
 
 	wgSync.Add(1)
 	_ = pool.Submit(func() {
-		switch siteId {
-		case 1:
-			// автор со сберзвука
-			res, err = DeleteArtistsDb(context.WithoutCancel(ctx), siteId, []string{artistId}, true)
-		case 2:
-			// автор со спотика
-		case 3:
-			// автор с дизера
-		case 4:
-			// автор с ютуба
-			res, err = DeleteChannelDb(context.WithoutCancel(ctx), siteId, []string{artistId})
-		}
+		txMu.Lock()
+		res, err = p.DeleteArtist(context.WithoutCancel(ctx), artistId)
+		txMu.Unlock()
 		wgSync.Done()
 	})
 	wgSync.Wait()
 
 	if err != nil {
 		log.Printf("Delete error: %v", err)
-		return nil, status.Errorf(
-			codes.Internal,
-			"Internal error",
-		)
+		return nil, toStatus(err)
 	} else {
 		fmt.Printf("siteId: %v, deleting artist %v completed\n", siteId, artistId)
 	}
@@ -374,33 +422,30 @@ func (*server) SetPlanned(ctx context.Context, req *artist.SetPlannedRequest) (*
 	vId := req.GetVideoId()
 	fmt.Printf("siteId: %v, set planned video %v started\n", siteId, vId)
 
-	var (
-		res int64
-		err error
-	)
+	p, err := getProvider(siteId)
+	if err != nil {
+		return nil, err
+	}
+	pl, ok := p.(planner)
+	if !ok {
+		// для музыки не актуально, но можно в будущем
+		return nil, status.Errorf(codes.Unimplemented, "site %v has no planned items", siteId)
+	}
+
+	var res int64
 
 	wgSync.Add(1)
 	_ = pool.Submit(func() {
-		switch siteId {
-		// для музыки не актуально, но можно в будущем
-		case 2:
-			// автор со спотика
-		case 3:
-			// автор с дизера
-		case 4:
-			// автор с ютуба
-			res, err = SetPlannedDb(context.WithoutCancel(ctx), vId, req.GetState())
-		}
+		txMu.Lock()
+		res, err = pl.SetPlanned(context.WithoutCancel(ctx), vId, req.GetState())
+		txMu.Unlock()
 		wgSync.Done()
 	})
 	wgSync.Wait()
 
 	if err != nil {
 		log.Printf("Set planned error: %v", err)
-		return nil, status.Errorf(
-			codes.Internal,
-			"Internal error",
-		)
+		return nil, toStatus(err)
 	} else {
 		fmt.Printf("siteId: %v, set planned video %v completed\n", siteId, vId)
 	}
@@ -412,35 +457,25 @@ func (*server) ClearSync(ctx context.Context, req *artist.ClearSyncRequest) (*ar
 	siteId := req.GetSiteId()
 	fmt.Printf("siteId: %v, clear sync state started\n", siteId)
 
-	var (
-		res int64
-		err error
-	)
+	p, err := getProvider(siteId)
+	if err != nil {
+		return nil, err
+	}
+
+	var res int64
 
 	wgSync.Add(1)
 	_ = pool.Submit(func() {
-		switch siteId {
-		case 1:
-			// автор со сберзвука
-			res, err = ClearAlbSyncStateDb(context.WithoutCancel(ctx), siteId)
-		case 2:
-			// автор со спотика
-		case 3:
-			// автор с дизера
-		case 4:
-			// автор с ютуба
-			res, err = ClearVidSyncStateDb(context.WithoutCancel(ctx), siteId)
-		}
+		txMu.Lock()
+		res, err = p.ClearSync(context.WithoutCancel(ctx))
+		txMu.Unlock()
 		wgSync.Done()
 	})
 	wgSync.Wait()
 
 	if err != nil {
 		log.Printf("Clear error: %v", err)
-		return nil, status.Errorf(
-			codes.Internal,
-			"Internal error",
-		)
+		return nil, toStatus(err)
 	} else {
 		fmt.Printf("siteId: %v, clear sync state completed\n", siteId)
 	}
@@ -448,6 +483,55 @@ func (*server) ClearSync(ctx context.Context, req *artist.ClearSyncRequest) (*ar
 	return &artist.ClearSyncResponse{RowsAffected: res}, err
 }
 
+func (*server) AcknowledgeItems(ctx context.Context, req *artist.AcknowledgeItemsRequest) (*artist.AcknowledgeResponse, error) {
+	if len(req.GetIds()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "ids are required")
+	}
+	return acknowledgeSync(ctx, req.GetSiteId(), &acknowledge{ids: req.GetIds()})
+}
+
+func (*server) AcknowledgeArtist(ctx context.Context, req *artist.AcknowledgeArtistRequest) (*artist.AcknowledgeResponse, error) {
+	if req.GetArtistId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "artistId is required")
+	}
+	return acknowledgeSync(ctx, req.GetSiteId(), &acknowledge{artistId: req.GetArtistId()})
+}
+
+func (*server) AcknowledgeOlderThan(ctx context.Context, req *artist.AcknowledgeOlderThanRequest) (*artist.AcknowledgeResponse, error) {
+	if _, err := time.Parse(time.DateOnly, req.GetDate()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "bad date: %v", req.GetDate())
+	}
+	return acknowledgeSync(ctx, req.GetSiteId(), &acknowledge{before: req.GetDate()})
+}
+
+func acknowledgeSync(ctx context.Context, siteId uint32, ack *acknowledge) (*artist.AcknowledgeResponse, error) {
+	fmt.Printf("siteId: %v, acknowledge started\n", siteId)
+
+	p, err := getProvider(siteId)
+	if err != nil {
+		return nil, err
+	}
+
+	var aff, remaining int64
+
+	wgSync.Add(1)
+	_ = pool.Submit(func() {
+		txMu.Lock()
+		aff, remaining, err = p.Acknowledge(context.WithoutCancel(ctx), ack)
+		txMu.Unlock()
+		wgSync.Done()
+	})
+	wgSync.Wait()
+
+	if err != nil {
+		log.Printf("Acknowledge error: %v", err)
+		return nil, toStatus(err)
+	}
+	fmt.Printf("siteId: %v, acknowledge completed, affected: %v, new left: %v\n", siteId, aff, remaining)
+
+	return &artist.AcknowledgeResponse{RowsAffected: aff, RemainingNew: remaining}, nil
+}
+
 func (*server) DownloadAlbums(ctx context.Context, req *artist.DownloadAlbumsRequest) (*artist.DownloadAlbumsResponse, error) {
 	siteId := req.GetSiteId()
 	albIds := req.GetAlbumIds()
@@ -457,25 +541,11 @@ func (*server) DownloadAlbums(ctx context.Context, req *artist.DownloadAlbumsReq
 		resDown map[string]string
 	)
 
-	switch siteId {
-	case 1:
-		// mid, high, flac
-		resDown, err = DownloadAlbum(context.WithoutCancel(ctx), siteId, albIds, req.GetTrackQuality())
-	case 2:
-		// "артист со спотика"
-	case 3:
-		// "артист с дизера"
-	case 4:
-		// автор с ютуба
-		resDown, err = DownloadVideos(context.WithoutCancel(ctx), albIds, req.GetTrackQuality(), req.GetIsPl())
-	}
+	resDown, err = downloadAlbums(context.WithoutCancel(ctx), siteId, albIds, req.GetTrackQuality(), req.GetIsPl(), nil)
 
 	if err != nil {
 		log.Printf("Download error: %v", err)
-		return nil, status.Errorf(
-			codes.Internal,
-			"Internal error",
-		)
+		return nil, toStatus(err)
 	} else {
 		fmt.Printf("siteId: %v, download %v completed, total: %v\n", siteId, albIds, len(resDown))
 	}
@@ -490,32 +560,10 @@ func (*server) DownloadArtist(ctx context.Context, req *artist.DownloadArtistReq
 	artistId := req.GetArtistId()
 	fmt.Printf("siteId: %v, download author %v started\n", siteId, artistId)
 
-	var (
-		err     error
-		resDown map[string]string
-	)
-
-	switch siteId {
-	case 1:
-		// mid, high, flac
-		albIds, _ := GetArtistReleasesIdFromDb(ctx, siteId, artistId, false)
-		resDown, err = DownloadAlbum(context.WithoutCancel(ctx), siteId, albIds, req.GetTrackQuality())
-	case 2:
-		// "артист со спотика"
-	case 3:
-		// "артист с дизера"
-	case 4:
-		// автор с ютуба
-		vidIds, _ := GetChannelVideosIdFromDb(ctx, siteId, artistId, false)
-		resDown, err = DownloadVideos(context.WithoutCancel(ctx), vidIds, req.GetTrackQuality(), false)
-	}
-
+	resDown, err := downloadArtist(context.WithoutCancel(ctx), siteId, artistId, req.GetTrackQuality(), nil)
 	if err != nil {
 		log.Printf("Download error: %v", err)
-		return nil, status.Errorf(
-			codes.Internal,
-			"Internal error",
-		)
+		return nil, toStatus(err)
 	} else {
 		fmt.Printf("siteId: %v, download artist %v completed, total: %v\n", siteId, artistId, len(resDown))
 	}
@@ -525,29 +573,50 @@ func (*server) DownloadArtist(ctx context.Context, req *artist.DownloadArtistReq
 	}, nil
 }
 
+func (*server) DownloadAlbumsStream(req *artist.DownloadAlbumsRequest, stream artist.ArtistService_DownloadAlbumsStreamServer) error {
+	siteId := req.GetSiteId()
+	albIds := req.GetAlbumIds()
+	fmt.Printf("siteId: %v, download stream %v started\n", siteId, albIds)
+
+	var sendErr error
+
+	resDown, err := downloadAlbums(context.WithoutCancel(stream.Context()), siteId, albIds, req.GetTrackQuality(), req.GetIsPl(), func(event *artist.DownloadEvent) {
+		if sendErr != nil {
+			// клиент отвалился, докачаем молча
+			return
+		}
+		sendErr = stream.Send(event)
+		if sendErr != nil {
+			log.Printf("Download stream send error: %v", sendErr)
+		}
+	})
+
+	if err != nil {
+		log.Printf("Download error: %v", err)
+		return toStatus(err)
+	} else {
+		fmt.Printf("siteId: %v, download stream %v completed, total: %v\n", siteId, albIds, len(resDown))
+	}
+
+	return sendErr
+}
+
 func (*server) ListArtist(ctx context.Context, req *artist.ListArtistRequest) (*artist.ListArtistResponse, error) {
 	siteId := req.GetSiteId()
 	fmt.Printf("siteId: %v, list started\n", siteId)
 
-	var (
-		arts []*artist.Artist
-		err  error
-	)
+	p, err := getProvider(siteId)
+	if err != nil {
+		return nil, err
+	}
+
+	var arts []*artist.Artist
 
 	wgSync.Add(1)
 	_ = pool.Submit(func() {
-		switch siteId {
-		case 1:
-			// автор со сберзвука
-			arts, err = GetArtists(context.WithoutCancel(ctx), siteId)
-		case 2:
-			// автор со спотика
-		case 3:
-			// автор с дизера
-		case 4:
-			// автор с ютуба
-			arts, err = GetChannels(context.WithoutCancel(ctx), siteId)
-		}
+		txMu.Lock()
+		arts, err = p.ListArtists(context.WithoutCancel(ctx))
+		txMu.Unlock()
 		wgSync.Done()
 	})
 	wgSync.Wait()
@@ -559,6 +628,350 @@ func (*server) ListArtist(ctx context.Context, req *artist.ListArtistRequest) (*
 	}, err
 }
 
+func (*server) SubmitJob(ctx context.Context, req *artist.SubmitJobRequest) (*artist.Job, error) {
+	siteId := req.GetSiteId()
+	fmt.Printf("siteId: %v, submit job type %v started\n", siteId, req.GetJobType())
+
+	switch req.GetJobType() {
+	case jobTypeDownloadAlbums:
+		if len(req.GetAlbumIds()) == 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "albumIds are required")
+		}
+	case jobTypeDownloadArtist, jobTypeSyncArtist:
+		if req.GetArtistId() == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "artistId is required")
+		}
+	case jobTypeBulkAdd:
+		if len(req.GetArtistIds()) == 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "artistIds are required")
+		}
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unknown job type: %v", req.GetJobType())
+	}
+
+	jobId, err := InsertJobDb(context.WithoutCancel(ctx), req)
+	if err != nil {
+		log.Printf("Submit job error: %v", err)
+		return nil, toStatus(err)
+	}
+	if err = enqueueJob(context.WithoutCancel(ctx), jobId); err != nil {
+		return nil, err
+	}
+	fmt.Printf("siteId: %v, submit job %v completed\n", siteId, jobId)
+
+	return getJob(ctx, jobId)
+}
+
+func (*server) GetJob(ctx context.Context, req *artist.JobRequest) (*artist.Job, error) {
+	return getJob(ctx, req.GetJobId())
+}
+
+func (*server) ListJobs(ctx context.Context, req *artist.ListJobsRequest) (*artist.ListJobsResponse, error) {
+	jobs, err := ListJobsDb(ctx, req.GetSiteId(), req.GetStates(), req.GetLimit())
+	if err != nil {
+		log.Printf("List jobs error: %v", err)
+		return nil, toStatus(err)
+	}
+
+	return &artist.ListJobsResponse{Jobs: jobs}, nil
+}
+
+func (*server) CancelJob(ctx context.Context, req *artist.JobRequest) (*artist.Job, error) {
+	jobId := req.GetJobId()
+	fmt.Printf("cancel job %v started\n", jobId)
+
+	aff, err := cancelJob(context.WithoutCancel(ctx), jobId)
+	if err != nil {
+		log.Printf("Cancel job error: %v", err)
+		return nil, toStatus(err)
+	}
+	if aff == 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "job %v is not queued or running", jobId)
+	}
+
+	return getJob(ctx, jobId)
+}
+
+func (*server) RetryJob(ctx context.Context, req *artist.JobRequest) (*artist.Job, error) {
+	jobId := req.GetJobId()
+	fmt.Printf("retry job %v started\n", jobId)
+
+	if _, err := getJob(ctx, jobId); err != nil {
+		return nil, err
+	}
+	err := retryJob(context.WithoutCancel(ctx), jobId)
+	if err != nil {
+		log.Printf("Retry job error: %v", err)
+		return nil, toStatus(err)
+	}
+
+	return getJob(ctx, jobId)
+}
+
+func (s *server) BulkAddArtists(ctx context.Context, req *artist.BulkAddArtistsRequest) (*artist.Job, error) {
+	if _, err := getProvider(req.GetSiteId()); err != nil {
+		return nil, err
+	}
+
+	return s.SubmitJob(ctx, &artist.SubmitJobRequest{
+		SiteId:    req.GetSiteId(),
+		JobType:   jobTypeBulkAdd,
+		ArtistIds: req.GetIds(),
+	})
+}
+
+func (*server) Search(ctx context.Context, req *artist.SearchRequest) (*artist.SearchResponse, error) {
+	query := strings.TrimSpace(req.GetQuery())
+	if query == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty query")
+	}
+	fmt.Printf("siteId: %v, search: %v started\n", req.GetSiteId(), query)
+
+	res, err := SearchDb(context.WithoutCancel(ctx), query, req.GetSiteId(), req.GetLimit(), req.GetMarkStart(), req.GetMarkEnd())
+	if err != nil {
+		log.Printf("Search error: %v", err)
+		return nil, toStatus(err)
+	}
+	fmt.Printf("siteId: %v, search: %v completed, total: %v\n", req.GetSiteId(), query, len(res))
+
+	return &artist.SearchResponse{Results: res}, nil
+}
+
+func (*server) SuggestArtists(ctx context.Context, req *artist.SuggestArtistsRequest) (*artist.SuggestArtistsResponse, error) {
+	siteId := req.GetSiteId()
+	fmt.Printf("siteId: %v, suggest artists started\n", siteId)
+
+	p, err := getProvider(siteId)
+	if err != nil {
+		return nil, err
+	}
+	sg, ok := p.(suggester)
+	if !ok {
+		return nil, status.Errorf(codes.Unimplemented, "site %v has no co-artists", siteId)
+	}
+
+	res, err := sg.SuggestArtists(context.WithoutCancel(ctx), req.GetLimit())
+	if err != nil {
+		log.Printf("Suggest error: %v", err)
+		return nil, toStatus(err)
+	}
+	fmt.Printf("siteId: %v, suggest artists completed, total: %v\n", siteId, len(res))
+
+	return &artist.SuggestArtistsResponse{Artists: res}, nil
+}
+
+func (*server) RefreshVideoStats(ctx context.Context, req *artist.RefreshVideoStatsRequest) (*artist.RefreshVideoStatsResponse, error) {
+	siteId := req.GetSiteId()
+	fmt.Printf("siteId: %v, refresh stats: %v started\n", siteId, req.GetChannelId())
+
+	p, err := getProvider(siteId)
+	if err != nil {
+		return nil, err
+	}
+	sk, ok := p.(statsKeeper)
+	if !ok {
+		return nil, status.Errorf(codes.Unimplemented, "site %v has no stats", siteId)
+	}
+
+	days := int(req.GetDays())
+	if days <= 0 {
+		days = StatsDays
+	}
+	res, err := sk.RefreshStats(context.WithoutCancel(ctx), req.GetChannelId(), days)
+	if err != nil {
+		log.Printf("Refresh stats error: %v", err)
+		return nil, toStatus(err)
+	}
+	fmt.Printf("siteId: %v, refresh stats: %v completed, videos: %v\n", siteId, req.GetChannelId(), res)
+
+	return &artist.RefreshVideoStatsResponse{VideoCount: int32(res)}, nil
+}
+
+func (*server) GetVideoStats(ctx context.Context, req *artist.VideoStatsRequest) (*artist.VideoStatsResponse, error) {
+	siteId := req.GetSiteId()
+	if req.GetVideoId() == "" && req.GetChannelId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "video or channel id is required")
+	}
+
+	p, err := getProvider(siteId)
+	if err != nil {
+		return nil, err
+	}
+	sk, ok := p.(statsKeeper)
+	if !ok {
+		return nil, status.Errorf(codes.Unimplemented, "site %v has no stats", siteId)
+	}
+
+	points, err := sk.StatsTrend(ctx, req.GetVideoId(), req.GetChannelId(), int(req.GetDays()))
+	if err != nil {
+		log.Printf("Video stats error: %v", err)
+		return nil, toStatus(err)
+	}
+
+	return &artist.VideoStatsResponse{Points: points}, nil
+}
+
+func (*server) SetSyncSettings(ctx context.Context, req *artist.SetSyncSettingsRequest) (*artist.SyncSettings, error) {
+	siteId := req.GetSiteId()
+	artistId := req.GetArtistId()
+	fmt.Printf("siteId: %v, sync settings of %v started\n", siteId, artistId)
+
+	if _, err := getProvider(siteId); err != nil {
+		return nil, err
+	}
+	if err := validateSyncSettings(req.GetSettings()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	res, err := SetSyncSettingsDb(context.WithoutCancel(ctx), siteId, artistId, req.GetSettings())
+	if err != nil {
+		log.Printf("Sync settings error: %v", err)
+		return nil, toStatus(err)
+	}
+	if res == nil {
+		return nil, status.Errorf(codes.NotFound, "artist %v not found", artistId)
+	}
+	fmt.Printf("siteId: %v, sync settings of %v completed\n", siteId, artistId)
+
+	return res, nil
+}
+
+func (*server) ListSyncRuns(ctx context.Context, req *artist.ListSyncRunsRequest) (*artist.ListSyncRunsResponse, error) {
+	limit := req.GetLimit()
+	if limit <= 0 {
+		limit = syncRunLimit
+	}
+	runs, err := ListSyncRunsDb(ctx, req.GetSiteId(), req.GetArtistId(), req.GetFailedOnly(), min(limit, maxSyncRunLimit))
+	if err != nil {
+		log.Printf("List sync runs error: %v", err)
+		return nil, toStatus(err)
+	}
+
+	return &artist.ListSyncRunsResponse{Runs: runs}, nil
+}
+
+func (*server) GetSyncRun(ctx context.Context, req *artist.SyncRunRequest) (*artist.SyncRun, error) {
+	runId := req.GetRunId()
+	run, err := GetSyncRunDb(ctx, runId, req.GetFailedOnly())
+	if err != nil {
+		log.Printf("Sync run error: %v", err)
+		return nil, toStatus(err)
+	}
+	if run == nil {
+		return nil, status.Errorf(codes.NotFound, "sync run %v not found", runId)
+	}
+
+	return run, nil
+}
+
+func (*server) GetSyncSchedule(_ context.Context, req *artist.SyncScheduleRequest) (*artist.SyncScheduleResponse, error) {
+	siteId := req.GetSiteId()
+	if siteId != 0 {
+		if _, err := getProvider(siteId); err != nil {
+			return nil, err
+		}
+	}
+
+	return &artist.SyncScheduleResponse{Schedules: GetSchedules(siteId)}, nil
+}
+
+func (*server) CreateDownloadRule(ctx context.Context, req *artist.DownloadRule) (*artist.DownloadRule, error) {
+	if req.GetId() != 0 {
+		return nil, status.Error(codes.InvalidArgument, "new rule can't have an id")
+	}
+	return saveDownloadRule(ctx, req)
+}
+
+func (*server) UpdateDownloadRule(ctx context.Context, req *artist.DownloadRule) (*artist.DownloadRule, error) {
+	if req.GetId() == 0 {
+		return nil, status.Error(codes.InvalidArgument, "rule id is required")
+	}
+	return saveDownloadRule(ctx, req)
+}
+
+func saveDownloadRule(ctx context.Context, rule *artist.DownloadRule) (*artist.DownloadRule, error) {
+	siteId := rule.GetSiteId()
+	fmt.Printf("siteId: %v, download rule %v started\n", siteId, rule.GetId())
+
+	p, err := getProvider(siteId)
+	if err != nil {
+		return nil, err
+	}
+	if err = validateDownloadRule(ctx, p, rule); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	res, err := SaveDownloadRuleDb(context.WithoutCancel(ctx), rule)
+	if err != nil {
+		log.Printf("Download rule error: %v", err)
+		return nil, toStatus(err)
+	}
+	if res == nil {
+		return nil, status.Errorf(codes.NotFound, "rule %v not found", rule.GetId())
+	}
+	fmt.Printf("siteId: %v, download rule %v completed\n", siteId, res.GetId())
+
+	return res, nil
+}
+
+func (*server) DeleteDownloadRule(ctx context.Context, req *artist.DownloadRuleRequest) (*artist.DeleteDownloadRuleResponse, error) {
+	ruleId := req.GetRuleId()
+	aff, err := DeleteDownloadRuleDb(context.WithoutCancel(ctx), ruleId)
+	if err != nil {
+		log.Printf("Delete download rule error: %v", err)
+		return nil, toStatus(err)
+	}
+	if aff == 0 {
+		return nil, status.Errorf(codes.NotFound, "rule %v not found", ruleId)
+	}
+
+	return &artist.DeleteDownloadRuleResponse{RowsAffected: aff}, nil
+}
+
+func (*server) ListDownloadRules(ctx context.Context, req *artist.ListDownloadRulesRequest) (*artist.ListDownloadRulesResponse, error) {
+	rules, err := ListDownloadRulesDb(ctx, req.GetSiteId(), req.GetArtistId(), false)
+	if err != nil {
+		log.Printf("List download rules error: %v", err)
+		return nil, toStatus(err)
+	}
+
+	return &artist.ListDownloadRulesResponse{Rules: rules}, nil
+}
+
+func (*server) PreviewDownloadRules(ctx context.Context, req *artist.PreviewDownloadRulesRequest) (*artist.PreviewDownloadRulesResponse, error) {
+	siteId := req.GetSiteId()
+	p, err := getProvider(siteId)
+	if err != nil {
+		return nil, err
+	}
+	for _, rule := range req.GetRules() {
+		rule.SiteId = siteId
+		if err = validateDownloadRule(ctx, p, rule); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	decisions, err := PreviewDownloadRules(ctx, siteId, req.GetArtistId(), req.GetRules())
+	if err != nil {
+		log.Printf("Preview download rules error: %v", err)
+		return nil, toStatus(err)
+	}
+
+	return &artist.PreviewDownloadRulesResponse{Decisions: decisions}, nil
+}
+
+func getJob(ctx context.Context, jobId int64) (*artist.Job, error) {
+	job, err := GetJobDb(ctx, jobId)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return nil, status.Errorf(codes.NotFound, "job %v not found", jobId)
+	case err != nil:
+		log.Printf("Get job error: %v", err)
+		return nil, toStatus(err)
+	}
+	return job, nil
+}
+
 func main() {
 	defer ants.Release()
 	err := godotenv.Load(".env")
@@ -579,6 +992,11 @@ func main() {
 	if ZvukDir == "" {
 		ZvukDir, _ = os.UserHomeDir()
 	}
+	FullSyncDays = envInt("FULLSYNCDAYS", defaultFullSyncDays)
+	StatsDays = envInt("STATSDAYS", defaultStatsDays)
+	QuotaBudget = int64(envInt("YOUQUOTA", defaultQuotaBudget))
+	SyncHours = envInt("SYNCHOURS", defaultSyncHours)
+	SyncRunDays = envInt("SYNCRUNDAYS", defaultSyncRunDays)
 	YouDir = os.Getenv("YOUDIR")
 	if YouDir == "" {
 		YouDir, _ = os.UserHomeDir()
@@ -598,6 +1016,7 @@ func main() {
 	var opts []grpc.ServerOption
 	newServer := grpc.NewServer(opts...)
 	artist.RegisterArtistServiceServer(newServer, &server{})
+	artist.RegisterAdminServiceServer(newServer, &adminServer{})
 	// Register reflection service on gRPC server.
 	// reflection.Register(newServer)
 
@@ -614,9 +1033,32 @@ func main() {
 		}
 	}(pool, 5*time.Second)
 
+	initLimits()
+	defer releaseLimits(5 * time.Second)
+
+	if err = MigrateDb(context.Background()); err != nil {
+		log.Printf("migrate db: %v", err)
+	}
+	RecoverSyncRunsDb(context.Background())
+	jobIds, err := RecoverJobsDb(context.Background())
+	if err != nil {
+		log.Printf("recover jobs: %v", err)
+	}
+	for _, jobId := range jobIds {
+		if er := enqueueJob(context.Background(), jobId); er != nil {
+			log.Printf("job %v: %v", jobId, er)
+		}
+	}
+	go runJobs()
+	loadQuotaDb(context.Background())
+	go quotaFlushLoop()
+	go refreshStatsLoop(time.Duration(envInt("STATSHOURS", defaultStatsHours)) * time.Hour)
+	startSchedules()
+
 	go func() {
 		s := <-sigCh
 		fmt.Printf("got signal %v, attempting graceful shutdown\n", s)
+		flushQuotaDb(context.Background())
 		vacuumDb(context.Background())
 		newServer.GracefulStop()
 		wg.Done()
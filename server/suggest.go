@@ -0,0 +1,109 @@
+package main
+
+import (
+	"cmp"
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+
+	"github.com/v0vc/go-music-grpc/artist"
+	slices2 "golang.org/x/exp/slices"
+)
+
+const (
+	suggestLimit    = 20
+	suggestMaxLimit = 100
+	// релиз возрастом suggestAgeDays весит вдвое меньше нового
+	suggestAgeDays = 365
+)
+
+// suggester is implemented by providers that know co-artists of the subscribed ones
+type suggester interface {
+	SuggestArtists(ctx context.Context, limit int32) ([]*artist.SuggestedArtist, error)
+}
+
+func (z *zvukProvider) SuggestArtists(ctx context.Context, limit int32) ([]*artist.SuggestedArtist, error) {
+	return SuggestArtistsDb(ctx, z.siteId, limit)
+}
+
+// SuggestArtistsDb ranks artists we are not subscribed to by releases shared with the subscribed ones.
+// Every shared release adds 1 / (1 + age / suggestAgeDays), so fresh collaborations go first.
+func SuggestArtistsDb(ctx context.Context, siteId uint32, limit int32) ([]*artist.SuggestedArtist, error) {
+	db, err := sql.Open(sqlite3, fmt.Sprintf("file:%v?cache=shared&mode=ro", dbFile))
+	if err != nil {
+		log.Println(err)
+	}
+	defer func(db *sql.DB) {
+		err = db.Close()
+		if err != nil {
+			log.Println(err)
+		}
+	}(db)
+
+	if limit <= 0 {
+		limit = suggestLimit
+	}
+	limit = min(limit, suggestMaxLimit)
+
+	// sa - артисты, на которых подписаны, ca - кандидаты с тех же релизов
+	rows, err := db.QueryContext(ctx, `select c.art_id, c.artistId, ifnull(c.title, ''), a.alb_id, a.albumId, ifnull(a.title, ''), ifnull(a.releaseDate, ''), a.releaseType, ifnull(a.removedAt, ''),
+       (select group_concat(s.title, ', ') from main.artistAlbum sa join main.artist s on s.art_id = sa.artistId where sa.albumId = ca.albumId and s.userAdded = 1) as via,
+       1.0 / (1 + max(julianday('now') - ifnull(julianday(a.releaseDate), julianday('now')), 0) / ?) as weight
+from main.artistAlbum ca
+         join main.artist c on c.art_id = ca.artistId
+         join main.album a on a.alb_id = ca.albumId
+where c.siteId = ?
+  and c.userAdded = 0
+  and via is not null
+order by a.releaseDate desc;`, suggestAgeDays, siteId)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	defer func(rows *sql.Rows) {
+		err = rows.Close()
+		if err != nil {
+			log.Println(err)
+		}
+	}(rows)
+
+	var res []*artist.SuggestedArtist
+	mArtist := make(map[int64]*artist.SuggestedArtist)
+
+	for rows.Next() {
+		var (
+			art    artist.Artist
+			alb    artist.Album
+			weight float64
+		)
+		if err = rows.Scan(&art.Id, &art.ArtistId, &art.Title, &alb.Id, &alb.AlbumId, &alb.Title, &alb.ReleaseDate, &alb.ReleaseType, &alb.RemovedAt, &alb.SubTitle, &weight); err != nil {
+			log.Println(err)
+			continue
+		}
+
+		sug, ok := mArtist[art.Id]
+		if !ok {
+			art.SiteId = siteId
+			sug = &artist.SuggestedArtist{Artist: &art}
+			mArtist[art.Id] = sug
+			res = append(res, sug)
+		}
+		sug.Score += weight
+		sug.Releases = append(sug.Releases, &alb)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	slices2.SortStableFunc(res, func(a, b *artist.SuggestedArtist) int {
+		if c := cmp.Compare(b.GetScore(), a.GetScore()); c != 0 {
+			return c
+		}
+		return cmp.Compare(len(b.GetReleases()), len(a.GetReleases()))
+	})
+	if len(res) > int(limit) {
+		res = res[:limit]
+	}
+	return res, nil
+}
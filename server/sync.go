@@ -0,0 +1,194 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	slices2 "golang.org/x/exp/slices"
+
+	"github.com/v0vc/go-music-grpc/artist"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	syncEventStarted int32 = iota
+	syncEventAlbums
+	syncEventArtists
+	syncEventError
+	syncEventFinished
+	syncEventSummary
+)
+
+// fullSyncs holds the sites with a sync of all artists going, whoever started it: the schedule, a job or the rpc
+var fullSyncs sync.Map
+
+func fullSyncRunning(siteId uint32) bool {
+	_, ok := fullSyncs.Load(siteId)
+	return ok
+}
+
+// syncArtists runs the sync for one artist or, with artistId "-1", for every artist of the site,
+// reporting each step through onEvent. Artists are synced in parallel on the pool of the site, but onEvent
+// is never called concurrently. Failures of single artists in a full sync are only reported as events,
+// so the caller gets an error only when the ids can't be read or a single artist fails.
+// Every run and the outcome of each artist are kept in the sync journal, trigger tells who started it.
+func syncArtists(ctx context.Context, req *artist.SyncArtistRequest, trigger int32, onEvent func(*artist.SyncArtistEvent)) ([]*artist.Artist, error) {
+	siteId := req.GetSiteId()
+	artistId := req.GetArtistId()
+
+	var artists []*artist.Artist
+
+	p, err := getProvider(siteId)
+	if err != nil {
+		return nil, err
+	}
+
+	if artistId == "-1" {
+		// второй полный синк только повторит те же запросы к сайту
+		if _, busy := fullSyncs.LoadOrStore(siteId, true); busy {
+			return nil, status.Errorf(codes.FailedPrecondition, "full sync of site %v is already running", siteId)
+		}
+		defer fullSyncs.Delete(siteId)
+	}
+
+	started := time.Now()
+	summary := &artist.SyncArtistSummary{}
+	summary.RunId = startSyncRunDb(context.WithoutCancel(ctx), siteId, artistId, trigger, req.GetIsAdd())
+	defer func() {
+		finishSyncRunDb(context.WithoutCancel(ctx), summary.GetRunId(), summary, time.Since(started), err)
+	}()
+
+	artIds, err := p.ArtistIds(ctx, artistId)
+	if err != nil {
+		return nil, err
+	}
+
+	total := int32(len(artIds))
+
+	var (
+		mu sync.Mutex
+		wg sync.WaitGroup
+	)
+
+	// артисты идут параллельно на пуле сайта, события и итоги собираем под mu
+	for i, artId := range artIds {
+		wg.Add(1)
+		submitSync(siteId, func() {
+			defer wg.Done()
+
+			if ctx.Err() != nil {
+				// синк отменили, оставшихся артистов не трогаем
+				mu.Lock()
+				summary.SkippedCount++
+				mu.Unlock()
+				return
+			}
+
+			mu.Lock()
+			onEvent(&artist.SyncArtistEvent{
+				EventType: syncEventStarted,
+				SiteId:    siteId,
+				ArtistId:  artId.Id,
+				Current:   int32(i + 1),
+				Total:     total,
+			})
+			mu.Unlock()
+
+			artStarted := time.Now()
+			art, newArtists, deletedArtIds, er := p.SyncArtist(context.WithoutCancel(ctx), artId, req.GetIsAdd())
+			er = withArtist(er, artId.Id)
+			if er == nil && art == nil {
+				er = fmt.Errorf("no data for artist: %v", artId.Id)
+			}
+			// журнал пишем до mu, чтобы не держать события остальных артистов
+			addSyncRunItemDb(context.WithoutCancel(ctx), summary.GetRunId(), newSyncRunItem(artId.Id, art, len(newArtists), er, artStarted))
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			summary.ArtistCount++
+			if er != nil {
+				log.Printf("Sync error: %v", er)
+				summary.FailedCount++
+				onEvent(&artist.SyncArtistEvent{
+					EventType: syncEventError,
+					SiteId:    siteId,
+					ArtistId:  artId.Id,
+					Current:   int32(i + 1),
+					Total:     total,
+					Error:     er.Error(),
+				})
+				if artistId != "-1" {
+					err = er
+				}
+				return
+			}
+
+			for _, id := range deletedArtIds {
+				if !slices2.Contains(summary.DeletedArtistIds, id) {
+					summary.DeletedArtistIds = append(summary.DeletedArtistIds, id)
+				}
+			}
+
+			summary.RemovedAlbumCount += int32(len(art.GetDeletedAlb()))
+
+			if len(art.GetAlbums()) > 0 {
+				summary.NewAlbumCount += int32(len(art.GetAlbums()))
+				onEvent(&artist.SyncArtistEvent{
+					EventType: syncEventAlbums,
+					SiteId:    siteId,
+					ArtistId:  art.GetArtistId(),
+					Title:     art.GetTitle(),
+					Current:   int32(i + 1),
+					Total:     total,
+					Albums:    art.GetAlbums(),
+				})
+			}
+
+			if len(newArtists) > 0 {
+				summary.NewArtistCount += int32(len(newArtists))
+				onEvent(&artist.SyncArtistEvent{
+					EventType: syncEventArtists,
+					SiteId:    siteId,
+					ArtistId:  art.GetArtistId(),
+					Title:     art.GetTitle(),
+					Current:   int32(i + 1),
+					Total:     total,
+					Artists:   newArtists,
+				})
+			}
+
+			onEvent(&artist.SyncArtistEvent{
+				EventType: syncEventFinished,
+				SiteId:    siteId,
+				ArtistId:  art.GetArtistId(),
+				Title:     art.GetTitle(),
+				Current:   int32(i + 1),
+				Total:     total,
+			})
+			artists = append(artists, art)
+		})
+	}
+	wg.Wait()
+
+	if summary.GetSkippedCount() > 0 {
+		err = ctx.Err()
+	} else if !req.GetIsAdd() && ctx.Err() == nil {
+		// правила смотрят только на свежие релизы, при добавлении артиста все его релизы не новые
+		summary.DownloadJobIds = applyDownloadRules(context.WithoutCancel(ctx), siteId, artists)
+	}
+
+	onEvent(&artist.SyncArtistEvent{
+		EventType: syncEventSummary,
+		SiteId:    siteId,
+		ArtistId:  artistId,
+		Total:     total,
+		Summary:   summary,
+	})
+
+	return artists, err
+}
@@ -0,0 +1,320 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/v0vc/go-music-grpc/artist"
+)
+
+const (
+	syncTriggerRpc int32 = iota
+	syncTriggerStream
+	syncTriggerJob
+	syncTriggerBulk
+	syncTriggerSchedule
+)
+
+const (
+	syncRunRunning int32 = iota
+	syncRunDone
+	syncRunFailed
+	syncRunInterrupted
+)
+
+const (
+	defaultSyncRunDays = 90
+	syncRunLimit       = 50
+	maxSyncRunLimit    = 500
+)
+
+// SyncRunDays - сколько дней держим журнал синков, старое чистим при каждом новом запуске
+var SyncRunDays = defaultSyncRunDays
+
+// newSyncRunItem is the outcome of one artist, provider errors keep their reason and http status
+func newSyncRunItem(artistId string, art *artist.Artist, newArtists int, err error, started time.Time) *artist.SyncRunItem {
+	item := &artist.SyncRunItem{
+		ArtistId:   artistId,
+		StartedAt:  started.UTC().Format(time.DateTime),
+		DurationMs: time.Since(started).Milliseconds(),
+	}
+	if art != nil {
+		item.Title = art.GetTitle()
+		item.NewAlbumCount = int32(len(art.GetAlbums()))
+		item.RemovedAlbumCount = int32(len(art.GetDeletedAlb()))
+		item.NewArtistCount = int32(newArtists)
+	}
+	if err != nil {
+		item.Error = err.Error()
+		var pe *ProviderError
+		if errors.As(err, &pe) {
+			item.Reason = errorReasons[pe.Kind]
+			item.StatusCode = int32(pe.StatusCode)
+		}
+	}
+	return item
+}
+
+// startSyncRunDb opens a journal entry and drops entries older than SyncRunDays, 0 is returned when it failed,
+// the sync itself goes on without the journal then
+func startSyncRunDb(ctx context.Context, siteId uint32, artistId string, trigger int32, isAdd bool) int64 {
+	txMu.Lock()
+	defer txMu.Unlock()
+
+	db, err := sql.Open(sqlite3, fmt.Sprintf("file:%v?_foreign_keys=true&cache=shared&mode=rw", dbFile))
+	if err != nil {
+		log.Println(err)
+	}
+	defer func(db *sql.DB) {
+		err = db.Close()
+		if err != nil {
+			log.Println(err)
+		}
+	}(db)
+
+	if SyncRunDays > 0 {
+		_, err = db.ExecContext(ctx, "delete from main.syncRun where startedAt < datetime('now', ?);", fmt.Sprintf("-%d days", SyncRunDays))
+		if err != nil {
+			log.Println(err)
+		}
+	}
+
+	var runId int64
+	err = db.QueryRowContext(ctx, "insert into main.syncRun(siteId, artistId, triggeredBy, state, isAdd) values (?,?,?,?,?) returning run_id;", siteId, artistId, trigger, syncRunRunning, isAdd).Scan(&runId)
+	if err != nil {
+		log.Println(err)
+		return 0
+	}
+	return runId
+}
+
+func addSyncRunItemDb(ctx context.Context, runId int64, item *artist.SyncRunItem) {
+	if runId == 0 {
+		return
+	}
+
+	txMu.Lock()
+	defer txMu.Unlock()
+
+	db, err := sql.Open(sqlite3, fmt.Sprintf("file:%v?_foreign_keys=true&cache=shared&mode=rw", dbFile))
+	if err != nil {
+		log.Println(err)
+	}
+	defer func(db *sql.DB) {
+		err = db.Close()
+		if err != nil {
+			log.Println(err)
+		}
+	}(db)
+
+	_, err = db.ExecContext(ctx, "insert into main.syncRunItem(runId, artistId, title, newAlbumCount, removedAlbumCount, newArtistCount, error, reason, statusCode, startedAt, durationMs) values (?,?,nullif(?, ''),?,?,?,nullif(?, ''),nullif(?, ''),?,?,?);",
+		runId, item.GetArtistId(), item.GetTitle(), item.GetNewAlbumCount(), item.GetRemovedAlbumCount(), item.GetNewArtistCount(), item.GetError(), item.GetReason(), item.GetStatusCode(), item.GetStartedAt(), item.GetDurationMs())
+	if err != nil {
+		log.Println(err)
+	}
+}
+
+// finishSyncRunDb closes the entry with the totals, a run is failed only when the sync returned an error
+func finishSyncRunDb(ctx context.Context, runId int64, summary *artist.SyncArtistSummary, duration time.Duration, runErr error) {
+	if runId == 0 {
+		return
+	}
+
+	txMu.Lock()
+	defer txMu.Unlock()
+
+	db, err := sql.Open(sqlite3, fmt.Sprintf("file:%v?_foreign_keys=false&cache=shared&mode=rw", dbFile))
+	if err != nil {
+		log.Println(err)
+	}
+	defer func(db *sql.DB) {
+		err = db.Close()
+		if err != nil {
+			log.Println(err)
+		}
+	}(db)
+
+	state, errText := syncRunDone, ""
+	if runErr != nil {
+		state, errText = syncRunFailed, runErr.Error()
+	}
+
+	_, err = db.ExecContext(ctx, "update main.syncRun set state = ?, finishedAt = CURRENT_TIMESTAMP, durationMs = ?, artistCount = ?, newAlbumCount = ?, removedAlbumCount = ?, newArtistCount = ?, failedCount = ?, error = nullif(?, '') where run_id = ?;",
+		state, duration.Milliseconds(), summary.GetArtistCount(), summary.GetNewAlbumCount(), summary.GetRemovedAlbumCount(), summary.GetNewArtistCount(), summary.GetFailedCount(), errText, runId)
+	if err != nil {
+		log.Println(err)
+	}
+}
+
+// RecoverSyncRunsDb marks runs that were going on when the server stopped as interrupted
+func RecoverSyncRunsDb(ctx context.Context) {
+	db, err := sql.Open(sqlite3, fmt.Sprintf("file:%v?_foreign_keys=false&cache=shared&mode=rw", dbFile))
+	if err != nil {
+		log.Println(err)
+	}
+	defer func(db *sql.DB) {
+		err = db.Close()
+		if err != nil {
+			log.Println(err)
+		}
+	}(db)
+
+	res, err := db.ExecContext(ctx, "update main.syncRun set state = ?, error = 'server was stopped' where state = ?;", syncRunInterrupted, syncRunRunning)
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	aff, _ := res.RowsAffected()
+	if aff > 0 {
+		fmt.Printf("sync runs interrupted by shutdown: %v\n", aff)
+	}
+}
+
+const syncRunColumns = "r.run_id, r.siteId, r.artistId, r.triggeredBy, r.state, r.isAdd, r.startedAt, ifnull(r.finishedAt,''), r.durationMs, r.artistCount, r.newAlbumCount, r.removedAlbumCount, r.newArtistCount, r.failedCount, ifnull(r.error,'')"
+
+func scanSyncRun(row interface{ Scan(dest ...any) error }) (*artist.SyncRun, error) {
+	var run artist.SyncRun
+	err := row.Scan(&run.Id, &run.SiteId, &run.ArtistId, &run.Trigger, &run.State, &run.IsAdd, &run.StartedAt, &run.FinishedAt, &run.DurationMs,
+		&run.ArtistCount, &run.NewAlbumCount, &run.RemovedAlbumCount, &run.NewArtistCount, &run.FailedCount, &run.Error)
+	if err != nil {
+		return nil, err
+	}
+	return &run, nil
+}
+
+// ListSyncRunsDb returns the newest runs first. With artistId only runs that synced the artist are returned,
+// each with the item of the artist, so a long row of failures is seen at once.
+func ListSyncRunsDb(ctx context.Context, siteId uint32, artistId string, failedOnly bool, limit int32) ([]*artist.SyncRun, error) {
+	db, err := sql.Open(sqlite3, fmt.Sprintf("file:%v?cache=shared&mode=ro", dbFile))
+	if err != nil {
+		log.Println(err)
+	}
+	defer func(db *sql.DB) {
+		err = db.Close()
+		if err != nil {
+			log.Println(err)
+		}
+	}(db)
+
+	var (
+		sb   strings.Builder
+		args []interface{}
+	)
+	sb.WriteString("select " + syncRunColumns + " from main.syncRun r where 1 = 1")
+	if siteId != 0 {
+		sb.WriteString(" and r.siteId = ?")
+		args = append(args, siteId)
+	}
+	switch {
+	case artistId != "" && failedOnly:
+		sb.WriteString(" and exists (select 1 from main.syncRunItem i where i.runId = r.run_id and i.artistId = ? and i.error is not null)")
+		args = append(args, artistId)
+	case artistId != "":
+		sb.WriteString(" and exists (select 1 from main.syncRunItem i where i.runId = r.run_id and i.artistId = ?)")
+		args = append(args, artistId)
+	case failedOnly:
+		sb.WriteString(" and (r.failedCount > 0 or r.state in (?,?))")
+		args = append(args, syncRunFailed, syncRunInterrupted)
+	}
+	sb.WriteString(" order by r.run_id desc limit ?;")
+	args = append(args, limit)
+
+	rows, err := db.QueryContext(ctx, sb.String(), args...)
+	if err != nil {
+		return nil, err
+	}
+	defer func(rows *sql.Rows) {
+		err = rows.Close()
+		if err != nil {
+			log.Println(err)
+		}
+	}(rows)
+
+	var runs []*artist.SyncRun
+	for rows.Next() {
+		run, er := scanSyncRun(rows)
+		if er != nil {
+			log.Println(er)
+		} else {
+			runs = append(runs, run)
+		}
+	}
+	if err = rows.Err(); err != nil || artistId == "" || len(runs) == 0 {
+		return runs, err
+	}
+
+	// у каждого запуска покажем строку этого артиста
+	mRuns := make(map[int64]*artist.SyncRun, len(runs))
+	for _, run := range runs {
+		mRuns[run.GetId()] = run
+	}
+	items, err := getSyncRunItemsDb(ctx, db, " and i.artistId = ? and i.runId between ? and ?", artistId, runs[len(runs)-1].GetId(), runs[0].GetId())
+	for runId, runItems := range items {
+		if run, ok := mRuns[runId]; ok {
+			run.Items = runItems
+		}
+	}
+	return runs, err
+}
+
+// GetSyncRunDb returns the run with its items, failed first, nil when there is no such run
+func GetSyncRunDb(ctx context.Context, runId int64, failedOnly bool) (*artist.SyncRun, error) {
+	db, err := sql.Open(sqlite3, fmt.Sprintf("file:%v?cache=shared&mode=ro", dbFile))
+	if err != nil {
+		log.Println(err)
+	}
+	defer func(db *sql.DB) {
+		err = db.Close()
+		if err != nil {
+			log.Println(err)
+		}
+	}(db)
+
+	run, err := scanSyncRun(db.QueryRowContext(ctx, "select "+syncRunColumns+" from main.syncRun r where r.run_id = ?;", runId))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	cond := " and i.runId = ?"
+	if failedOnly {
+		cond += " and i.error is not null"
+	}
+	items, err := getSyncRunItemsDb(ctx, db, cond, runId)
+	run.Items = items[runId]
+	return run, err
+}
+
+func getSyncRunItemsDb(ctx context.Context, db *sql.DB, cond string, args ...interface{}) (map[int64][]*artist.SyncRunItem, error) {
+	rows, err := db.QueryContext(ctx, "select i.runId, i.artistId, ifnull(i.title,''), i.newAlbumCount, i.removedAlbumCount, i.newArtistCount, ifnull(i.error,''), ifnull(i.reason,''), i.statusCode, i.startedAt, i.durationMs from main.syncRunItem i where 1 = 1"+cond+" order by i.error is null, i.startedAt;", args...)
+	if err != nil {
+		return nil, err
+	}
+	defer func(rows *sql.Rows) {
+		err = rows.Close()
+		if err != nil {
+			log.Println(err)
+		}
+	}(rows)
+
+	items := make(map[int64][]*artist.SyncRunItem)
+	for rows.Next() {
+		var (
+			runId int64
+			item  artist.SyncRunItem
+		)
+		if er := rows.Scan(&runId, &item.ArtistId, &item.Title, &item.NewAlbumCount, &item.RemovedAlbumCount, &item.NewArtistCount, &item.Error, &item.Reason, &item.StatusCode, &item.StartedAt, &item.DurationMs); er != nil {
+			log.Println(er)
+		} else {
+			items[runId] = append(items[runId], &item)
+		}
+	}
+	return items, rows.Err()
+}
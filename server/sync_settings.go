@@ -0,0 +1,95 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/v0vc/go-music-grpc/artist"
+)
+
+const (
+	// SyncHours - интервал по умолчанию для артистов без своего, 0 - синкаем при каждом проходе
+	defaultSyncHours = 0
+	// проход по таймеру приходит чуть раньше, чем через ровно час, запас не дает пропустить его
+	syncSlack = "-5 minutes"
+	// разумный потолок, дальше уже проще поставить на паузу
+	maxSyncHours = 24 * 90
+)
+
+var SyncHours = defaultSyncHours
+
+// syncTable is where the sync settings of a site live, artists of zvuk or channels of youtube
+type syncTable struct {
+	name, idCol, keyCol, cond string
+}
+
+var syncTables = map[uint32]syncTable{
+	siteZvuk:    {name: "artist", idCol: "art_id", keyCol: "artistId", cond: " and userAdded = 1"},
+	siteYoutube: {name: "channel", idCol: "ch_id", keyCol: "channelId"},
+}
+
+// syncDue is the condition of the sync of all artists: not paused and the own or the default interval has passed
+func syncDue(alias string) string {
+	return fmt.Sprintf(" and %[1]s.skipSync = 0 and (%[1]s.lastSync is null or datetime(%[1]s.lastSync, printf('+%%d hours', case when %[1]s.syncInterval > 0 then %[1]s.syncInterval else ? end), '%[2]s') <= datetime('now'))", alias, syncSlack)
+}
+
+// syncOrder puts favourites first, they get the pool before the rest
+func syncOrder(alias string) string {
+	return fmt.Sprintf(" order by %[1]s.priority desc, %[1]s.lastSync", alias)
+}
+
+// setLastSyncDb stamps a successful sync, the interval is counted from it
+func setLastSyncDb(tx *sql.Tx, ctx context.Context, siteId uint32, rawId int) {
+	t := syncTables[siteId]
+	_, err := tx.ExecContext(ctx, fmt.Sprintf("update main.%s set lastSync = datetime('now') where %s = ?;", t.name, t.idCol), rawId)
+	if err != nil {
+		log.Println(err)
+	}
+}
+
+func validateSyncSettings(settings *artist.SyncSettings) error {
+	if settings.GetIntervalHours() < 0 || settings.GetIntervalHours() > maxSyncHours {
+		return fmt.Errorf("bad sync interval: %v", settings.GetIntervalHours())
+	}
+	return nil
+}
+
+// SetSyncSettingsDb replaces the settings of the artist and returns them as stored, nil when there is no such artist
+func SetSyncSettingsDb(ctx context.Context, siteId uint32, artistId string, settings *artist.SyncSettings) (*artist.SyncSettings, error) {
+	t, ok := syncTables[siteId]
+	if !ok {
+		return nil, fmt.Errorf("no sync settings for site %v", siteId)
+	}
+
+	txMu.Lock()
+	defer txMu.Unlock()
+
+	db, err := sql.Open(sqlite3, fmt.Sprintf("file:%v?_foreign_keys=true&cache=shared&mode=rw", dbFile))
+	if err != nil {
+		log.Println(err)
+	}
+	defer func(db *sql.DB) {
+		err = db.Close()
+		if err != nil {
+			log.Println(err)
+		}
+	}(db)
+
+	var res artist.SyncSettings
+	var lastSync sql.NullString
+	err = db.QueryRowContext(ctx, fmt.Sprintf("update main.%s set skipSync = ?, syncInterval = ?, priority = ? where siteId = ? and %s = ?%s returning skipSync, syncInterval, priority, lastSync;", t.name, t.keyCol, t.cond),
+		settings.GetPaused(), settings.GetIntervalHours(), settings.GetPriority(), siteId, artistId).Scan(&res.Paused, &res.IntervalHours, &res.Priority, &lastSync)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	res.LastSync = lastSync.String
+
+	return &res, nil
+}
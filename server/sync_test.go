@@ -0,0 +1,148 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/v0vc/go-music-grpc/artist"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func fakeArtist(id string, albumIds ...string) *artist.Artist {
+	art := &artist.Artist{SiteId: siteFake, ArtistId: id, Title: "title " + id}
+	for _, albumId := range albumIds {
+		art.Albums = append(art.Albums, &artist.Album{AlbumId: albumId, Title: "album " + albumId, SyncState: 1})
+	}
+	return art
+}
+
+func collectEvents(events *[]*artist.SyncArtistEvent) func(*artist.SyncArtistEvent) {
+	return func(event *artist.SyncArtistEvent) {
+		*events = append(*events, event)
+	}
+}
+
+func TestSyncArtistsAll(t *testing.T) {
+	newTestDb(t)
+	f := newFakeProvider(t, fakeArtist("art1", "alb1", "alb2"), fakeArtist("art2"), fakeArtist("art3", "alb3"))
+	f.failWith("art2", &ProviderError{Kind: ErrThrottled, Provider: "fake", StatusCode: http.StatusTooManyRequests})
+
+	var events []*artist.SyncArtistEvent
+	artists, err := syncArtists(context.Background(), &artist.SyncArtistRequest{SiteId: siteFake, ArtistId: "-1"}, syncTriggerRpc, collectEvents(&events))
+	if err != nil {
+		t.Fatalf("a failed artist must not fail the full sync: %v", err)
+	}
+	if len(artists) != 2 {
+		t.Fatalf("got %v artists, want 2", len(artists))
+	}
+
+	count := make(map[int32]int)
+	for _, event := range events {
+		count[event.GetEventType()]++
+	}
+	want := map[int32]int{syncEventStarted: 3, syncEventAlbums: 2, syncEventError: 1, syncEventFinished: 2, syncEventSummary: 1}
+	for eventType, n := range want {
+		if count[eventType] != n {
+			t.Errorf("event %v: got %v, want %v", eventType, count[eventType], n)
+		}
+	}
+
+	last := events[len(events)-1]
+	if last.GetEventType() != syncEventSummary {
+		t.Fatalf("last event is %v, want the summary", last.GetEventType())
+	}
+	summary := last.GetSummary()
+	if summary.GetArtistCount() != 3 || summary.GetFailedCount() != 1 || summary.GetNewAlbumCount() != 3 {
+		t.Errorf("summary = %v", summary)
+	}
+
+	run, err := GetSyncRunDb(context.Background(), summary.GetRunId(), false)
+	if err != nil || run == nil {
+		t.Fatalf("GetSyncRunDb = %v, %v", run, err)
+	}
+	if run.GetState() != syncRunDone || run.GetArtistId() != "-1" || run.GetFailedCount() != 1 || len(run.GetItems()) != 3 {
+		t.Errorf("run = %v", run)
+	}
+	failed := run.GetItems()[0]
+	if failed.GetArtistId() != "art2" || failed.GetReason() != errorReasons[ErrThrottled] || failed.GetStatusCode() != http.StatusTooManyRequests {
+		t.Errorf("failed item goes first with the reason, got %v", failed)
+	}
+}
+
+func TestSyncArtistsOneFails(t *testing.T) {
+	newTestDb(t)
+	f := newFakeProvider(t, fakeArtist("art1"))
+	f.failWith("art1", &ProviderError{Kind: ErrNotFound, Provider: "fake", StatusCode: http.StatusNotFound})
+
+	var events []*artist.SyncArtistEvent
+	_, err := syncArtists(context.Background(), &artist.SyncArtistRequest{SiteId: siteFake, ArtistId: "art1"}, syncTriggerJob, collectEvents(&events))
+	if err == nil {
+		t.Fatal("a failed single artist must fail the sync")
+	}
+
+	runs, er := ListSyncRunsDb(context.Background(), siteFake, "art1", true, syncRunLimit)
+	if er != nil || len(runs) != 1 {
+		t.Fatalf("ListSyncRunsDb = %v, %v", runs, er)
+	}
+	if runs[0].GetState() != syncRunFailed || runs[0].GetTrigger() != syncTriggerJob || len(runs[0].GetItems()) != 1 {
+		t.Errorf("run = %v", runs[0])
+	}
+}
+
+func TestSyncArtistsNoData(t *testing.T) {
+	newTestDb(t)
+	newFakeProvider(t)
+
+	_, err := syncArtists(context.Background(), &artist.SyncArtistRequest{SiteId: siteFake, ArtistId: "art9"}, syncTriggerRpc, func(*artist.SyncArtistEvent) {})
+	if err == nil {
+		t.Fatal("an artist without data must be an error")
+	}
+}
+
+func TestSyncArtistsFullOverlap(t *testing.T) {
+	newTestDb(t)
+	f := newFakeProvider(t, fakeArtist("art1"))
+
+	fullSyncs.Store(siteFake, true)
+	_, err := syncArtists(context.Background(), &artist.SyncArtistRequest{SiteId: siteFake, ArtistId: "-1"}, syncTriggerJob, func(*artist.SyncArtistEvent) {})
+	fullSyncs.Delete(siteFake)
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("a second full sync must be refused, got %v", err)
+	}
+	if len(f.synced) != 0 {
+		t.Errorf("refused sync reached the provider: %v", f.synced)
+	}
+	if runs, er := ListSyncRunsDb(context.Background(), siteFake, "-1", true, syncRunLimit); er != nil || len(runs) != 0 {
+		t.Errorf("refused sync must not be journaled: %v, %v", runs, er)
+	}
+
+	if _, err = syncArtists(context.Background(), &artist.SyncArtistRequest{SiteId: siteFake, ArtistId: "-1"}, syncTriggerJob, func(*artist.SyncArtistEvent) {}); err != nil {
+		t.Fatalf("full sync after the first one ended: %v", err)
+	}
+	if fullSyncRunning(siteFake) {
+		t.Error("finished full sync still holds the site")
+	}
+}
+
+func TestSyncArtistsCancelled(t *testing.T) {
+	newTestDb(t)
+	f := newFakeProvider(t, fakeArtist("art1", "alb1"), fakeArtist("art2"))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	var events []*artist.SyncArtistEvent
+	_, err := syncArtists(ctx, &artist.SyncArtistRequest{SiteId: siteFake, ArtistId: "-1"}, syncTriggerJob, collectEvents(&events))
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("a cut short sync must report the cancel, got %v", err)
+	}
+	if len(f.synced) != 0 {
+		t.Errorf("cancelled sync reached the provider: %v", f.synced)
+	}
+	summary := events[len(events)-1].GetSummary()
+	if summary.GetSkippedCount() != 2 || summary.GetArtistCount() != 0 || len(summary.GetDownloadJobIds()) != 0 {
+		t.Errorf("summary = %v", summary)
+	}
+}
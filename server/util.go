@@ -110,6 +110,8 @@ func MapReleaseType(r string) int32 {
 		return 0
 	case "single":
 		return 1
+	case "compilation":
+		return 4
 	default:
 		return 0
 	}
@@ -140,6 +142,11 @@ func PrepareThumb(imgByte []byte, borderWidth int, length int, width int, jpegQu
 }
 
 func ConvertYoutubeDurationToSec(str string) string {
+	return toHumanTime(youtubeDurationSec(str))
+}
+
+// youtubeDurationSec parses the iso 8601 duration of the api, PT1H2M3S
+func youtubeDurationSec(str string) int {
 	n := len(str)
 	ans := 0
 	curr := 0
@@ -164,7 +171,7 @@ func ConvertYoutubeDurationToSec(str string) string {
 			curr = 10*curr + digit
 		}
 	}
-	return toHumanTime(ans)
+	return ans
 }
 
 func toHumanTime(duration int) string {
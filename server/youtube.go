@@ -31,7 +31,13 @@ func GetChannelIdsFromDb(ctx context.Context, siteId uint32) ([]ArtistRawId, err
 
 	var artistIds []ArtistRawId
 
-	stmtArt, err := db.PrepareContext(ctx, "select c.ch_id, c.channelId, p.pl_id, p.playlistId, group_concat(v.videoId, ',') from main.video v inner join main.playlistVideo pV on v.vid_id = pV.videoId inner join main.playlist p on p.pl_id = pV.playlistId inner join main.channelPlaylist cP on p.pl_id = cP.playlistId inner join main.channel c on c.ch_id = cP.channelId where p.playlistType = 0 and c.skipSync = 0 and c.siteId = ? group by c.ch_id;")
+	cond := syncDue("c")
+	if quota.near() {
+		// остаток квоты тратим на любимые каналы
+		cond += " and c.priority >= 0"
+		fmt.Printf("siteId: %v, quota is near the budget, low priority channels wait\n", siteId)
+	}
+	stmtArt, err := db.PrepareContext(ctx, "select c.ch_id, c.channelId, p.pl_id, p.playlistId, group_concat(v.videoId, ',') from main.video v inner join main.playlistVideo pV on v.vid_id = pV.videoId inner join main.playlist p on p.pl_id = pV.playlistId inner join main.channelPlaylist cP on p.pl_id = cP.playlistId inner join main.channel c on c.ch_id = cP.channelId where p.playlistType = 0 and c.siteId = ?"+cond+" group by c.ch_id"+syncOrder("c")+";")
 	if err != nil {
 		log.Println(err)
 	}
@@ -42,7 +48,7 @@ func GetChannelIdsFromDb(ctx context.Context, siteId uint32) ([]ArtistRawId, err
 		}
 	}(stmtArt)
 
-	rows, err := stmtArt.QueryContext(ctx, siteId)
+	rows, err := stmtArt.QueryContext(ctx, siteId, SyncHours)
 	if err != nil {
 		log.Println(err)
 	}
@@ -64,6 +70,82 @@ func GetChannelIdsFromDb(ctx context.Context, siteId uint32) ([]ArtistRawId, err
 	return artistIds, err
 }
 
+// channelNet is what the sync of a channel reads from the api. All of it is fetched before txMu is taken,
+// so a long channel doesn't hold the writes of zvuk, the jobs and the journal.
+type channelNet struct {
+	playlists []*plItem
+	// видео плейлистов кроме загрузок, плейлиста, который апи не отдало, тут нет
+	plVidIds map[string][]string
+	videos   []*vidItem
+	unlisted []*vidItem
+	shorts   map[string]bool
+}
+
+// fetchPlaylistVidIds reads the videos of the playlists and returns the ones that are not among the uploads
+func (n *channelNet) fetchPlaylistVidIds(ctx context.Context, token string, uploadIds []string) []string {
+	n.plVidIds = make(map[string][]string, len(n.playlists))
+
+	var notUploadId []string
+	for _, pl := range n.playlists {
+		netPlIds, err := GetPlaylistVidIds(ctx, pl.id, token)
+		if err != nil {
+			log.Println(err)
+			continue
+		}
+		n.plVidIds[pl.id] = netPlIds
+		for _, vId := range netPlIds {
+			if !slices2.Contains(uploadIds, vId) && !slices2.Contains(notUploadId, vId) {
+				notUploadId = append(notUploadId, vId)
+			}
+		}
+	}
+	return notUploadId
+}
+
+// fetchUnlisted keeps the videos of the other playlists that belong to the channel, unlisted ones are not in uploads
+func (n *channelNet) fetchUnlisted(ctx context.Context, token string, channelId string, notUploadId []string) {
+	for c := range slices.Chunk(notUploadId, 50) {
+		notListed := strings.Join(c, ",")
+		fmt.Println("found unlisted video(s): " + notListed)
+		chVideosIds, e := GetChannelIdsByVid(ctx, token, notListed, channelId)
+		if e != nil {
+			log.Println(e)
+		} else if chVideosIds != "" {
+			fmt.Println("processable unlisted video(s): " + chVideosIds)
+			unlistedVideos := GetVidByIds(ctx, chVideosIds, token)
+			if unlistedVideos != nil {
+				n.unlisted = append(n.unlisted, unlistedVideos...)
+			} else {
+				log.Println("can't get unlisted video from api")
+			}
+		}
+	}
+}
+
+// fetchDetails reads what the rows need besides the video items: the thumbnails and the shorts playlist
+func (n *channelNet) fetchDetails(ctx context.Context, token string, channelId string) {
+	all := slices.Concat(n.videos, n.unlisted)
+	n.shorts = getShortIds(ctx, token, channelId, all)
+	for _, vid := range all {
+		vid.thumbnail = GetThumb(ctx, vid.thumbnailLink)
+		if vid.thumbnail != nil {
+			vid.thumbnail = PrepareThumb(vid.thumbnail, 15, 64, 64, 90)
+		}
+	}
+}
+
+// readTx runs reads without txMu, the tx is only there to see one state of the base
+func readTx(ctx context.Context, db *sql.DB, read func(tx *sql.Tx)) error {
+	tx, err := db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelSerializable})
+	if err != nil {
+		return err
+	}
+	read(tx)
+	return tx.Rollback()
+}
+
+// SyncArtistYou adds or syncs a channel like SyncArtist does for zvuk: the base is read, then the api is asked,
+// and only the writes at the end take txMu
 func SyncArtistYou(ctx context.Context, siteId uint32, channelId ArtistRawId, isAdd bool) (*artist.Artist, error) {
 	db, err := sql.Open(sqlite3, fmt.Sprintf("file:%v?_foreign_keys=true&cache=shared&mode=rw", dbFile))
 	if err != nil {
@@ -76,26 +158,39 @@ func SyncArtistYou(ctx context.Context, siteId uint32, channelId ArtistRawId, is
 		}
 	}(db)
 
-	tx, err := db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelSerializable})
+	// заберем токен для работы с апи
+	var token string
+	err = readTx(ctx, db, func(tx *sql.Tx) {
+		token = GetTokenOnlyDb(tx, ctx, siteId)
+	})
 	if err != nil {
 		log.Println(err)
+		return nil, err
 	}
 
-	// заберем токен для работы с апи
-	token := GetTokenOnlyDb(tx, ctx, siteId)
-
 	// при добавлении мы поддерживаем все варианты на Ui (ссылка на видео, на канал и тд)
 	if isAdd && strings.HasPrefix(channelId.Id, "@") || len(channelId.Id) == 11 {
 		// с ui пришли либо имя канала с @, либо id видео, найдем id канала
 		chId, er := GetChannelId(ctx, token, channelId.Id)
 		if er != nil {
 			log.Println(er)
+			return nil, withArtist(er, channelId.Id)
 		}
 		channelId.Id = chId
 	}
 
-	if channelId.RawId == 0 {
-		channelId = getChannelIdDb(tx, ctx, siteId, channelId.Id, channelId.isPlSync)
+	var due bool
+	err = readTx(ctx, db, func(tx *sql.Tx) {
+		if channelId.RawId == 0 {
+			channelId = getChannelIdDb(tx, ctx, siteId, channelId.Id, channelId.isPlSync)
+		}
+		if channelId.RawId != 0 {
+			due = needChannelFullSyncDb(tx, ctx, channelId.RawId)
+		}
+	})
+	if err != nil {
+		log.Println(err)
+		return nil, err
 	}
 
 	if channelId.RawId != 0 && isAdd {
@@ -107,37 +202,234 @@ func SyncArtistYou(ctx context.Context, siteId uint32, channelId ArtistRawId, is
 	}
 
 	if isAdd {
-		ch, er := GetChannel(ctx, channelId.Id, token)
-		if er != nil || len(ch.Items) != 1 {
+		return addChannelYou(ctx, db, siteId, channelId, token)
+	}
+	return syncChannelYou(ctx, db, siteId, channelId, token, due)
+}
+
+func addChannelYou(ctx context.Context, db *sql.DB, siteId uint32, channelId ArtistRawId, token string) (*artist.Artist, error) {
+	ch, err := GetChannel(ctx, channelId.Id, token)
+	if err == nil && len(ch.Items) != 1 {
+		err = newProviderError(providerYoutube, ErrNotFound, fmt.Errorf("no channel for: %s", channelId.Id))
+	}
+	if err != nil {
+		log.Println(err)
+		return nil, withArtist(err, channelId.Id)
+	}
+
+	chThumb := GetThumb(ctx, ch.Items[0].Snippet.Thumbnails.Default.URL)
+	uploadPl := &plItem{
+		id:        ch.Items[0].ContentDetails.RelatedPlaylists.Uploads,
+		title:     "Uploads",
+		typePl:    0,
+		thumbnail: chThumb,
+	}
+
+	net := &channelNet{playlists: GetPlaylists(ctx, channelId.Id, token)}
+	net.videos, err = GetUploadVid(ctx, uploadPl.id, token)
+	if err != nil {
+		log.Println(err)
+		return nil, withArtist(err, channelId.Id)
+	}
+	if net.videos == nil {
+		log.Println("can't get video from api")
+	}
+	uploadIds := make([]string, 0, len(net.videos))
+	for _, vid := range net.videos {
+		uploadIds = append(uploadIds, vid.id)
+	}
+	net.fetchUnlisted(ctx, token, channelId.Id, net.fetchPlaylistVidIds(ctx, token, uploadIds))
+	net.fetchDetails(ctx, token, channelId.Id)
+
+	txMu.Lock()
+	defer txMu.Unlock()
+	tx, err := db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelSerializable})
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	stChannel, er := tx.PrepareContext(ctx, "insert into main.channel(siteId, channelId, title, thumbnail) values (?,?,?,?) on conflict (siteId, channelId) do update set syncState = 1 returning ch_id;")
+	if er != nil {
+		log.Println(er)
+	}
+	defer func(stChannel *sql.Stmt) {
+		er = stChannel.Close()
+		if er != nil {
 			log.Println(er)
-			return nil, tx.Rollback()
 		}
+	}(stChannel)
 
-		stChannel, er := tx.PrepareContext(ctx, "insert into main.channel(siteId, channelId, title, thumbnail) values (?,?,?,?) on conflict (siteId, channelId) do update set syncState = 1 returning ch_id;")
+	chTitle := ClearString(ch.Items[0].Snippet.Title)
+	insErr := stChannel.QueryRowContext(ctx, siteId, channelId.Id, chTitle, chThumb).Scan(&channelId.RawId)
+	if insErr != nil {
+		log.Println(insErr)
+	} else {
+		fmt.Printf("processed channel: %v, id: %v \n", ch.Items[0].Snippet.Title, &channelId.RawId)
+	}
+
+	resArtist := &artist.Artist{
+		SiteId:    siteId,
+		ArtistId:  channelId.Id,
+		Title:     ch.Items[0].Snippet.Title,
+		Thumbnail: chThumb,
+	}
+
+	stPlaylist, er := tx.PrepareContext(ctx, "insert into main.playlist(playlistId,title,playlistType,thumbnail) values (?,?,?,?) on conflict (playlistId, title) do nothing returning pl_id;")
+	if er != nil {
+		log.Println(er)
+	}
+	defer func(stPlaylist *sql.Stmt) {
+		er = stPlaylist.Close()
+		if er != nil {
+			log.Println(er)
+		}
+	}(stPlaylist)
+
+	stChPl, er := tx.PrepareContext(ctx, "insert into main.channelPlaylist(channelId, playlistId) values (?,?) on conflict do nothing;")
+	if er != nil {
+		log.Println(er)
+	}
+	defer func(stChPl *sql.Stmt) {
+		er = stChPl.Close()
 		if er != nil {
 			log.Println(er)
 		}
-		defer func(stChannel *sql.Stmt) {
-			er = stChannel.Close()
+	}(stChPl)
+
+	allPl := slices.Concat(net.playlists, []*plItem{uploadPl})
+	for i, item := range allPl {
+		var plId int
+		insEr := stPlaylist.QueryRowContext(ctx, item.id, ClearString(item.title), item.typePl, item.thumbnail).Scan(&plId)
+		if insEr != nil {
+			log.Println(insEr)
+		} else {
+			fmt.Printf("processed playlist: %v, id: %v \n", item.id, plId)
+			item.rawId = plId
+			_, er = stChPl.ExecContext(ctx, &channelId.RawId, plId)
 			if er != nil {
 				log.Println(er)
+			} else if i == len(allPl)-1 {
+				channelId.RawPlId = plId
 			}
-		}(stChannel)
+		}
+	}
+
+	var uploadVidIds map[string]int
+	if net.videos != nil {
+		uploadVidIds = processVideos(ctx, tx, net.videos, net.shorts, resArtist, uploadPl.rawId, channelId.Id, 0, 0)
+	}
+
+	for _, pl := range net.playlists {
+		netPlIds, ok := net.plVidIds[pl.id]
+		if !ok {
+			continue
+		}
+		plVid := make(map[string]int)
+		for _, vId := range netPlIds {
+			if rawVidId, ok := uploadVidIds[vId]; ok {
+				plVid[vId] = rawVidId
+			}
+		}
+		if len(plVid) == 0 {
+			deletePlaylistById(ctx, tx, pl.rawId)
+		} else {
+			insertPlaylistVideoIds(ctx, tx, plVid, pl.rawId)
+		}
+		resArtist.Playlists = append(resArtist.Playlists, &artist.Playlist{
+			PlaylistId:   pl.id,
+			Title:        ClearString(pl.title),
+			PlaylistType: 2,
+			Thumbnail:    pl.thumbnail,
+			VideoIds:     netPlIds,
+		})
+	}
+
+	insertUnlisted(ctx, tx, net, channelId, resArtist, 0)
+	setChannelFullSyncDb(tx, ctx, channelId.RawId)
+	setLastSyncDb(tx, ctx, siteId, channelId.RawId)
+
+	return resArtist, tx.Commit()
+}
 
-		chThumb := GetThumb(ctx, ch.Items[0].Snippet.Thumbnails.Default.URL)
-		chTitle := ClearString(ch.Items[0].Snippet.Title)
-		insErr := stChannel.QueryRowContext(ctx, siteId, channelId.Id, chTitle, chThumb).Scan(&channelId.RawId)
-		if insErr != nil {
-			log.Println(insErr)
+func syncChannelYou(ctx context.Context, db *sql.DB, siteId uint32, channelId ArtistRawId, token string, due bool) (*artist.Artist, error) {
+	// получим актуальные айдишники из ленты или апи
+	netIds, full, err := getNetVidIds(ctx, channelId, token, due)
+	if err != nil {
+		log.Println(err)
+		return nil, withArtist(err, channelId.Id)
+	}
+	// сравним
+	newVidIds := FindDifference(netIds, channelId.vidIds)
+	fmt.Printf("siteId: %v, channelId: %d, new videos: %d\n", siteId, channelId.RawId, len(newVidIds))
+
+	net := &channelNet{}
+	for c := range slices.Chunk(newVidIds, 50) {
+		videos := GetVidByIds(ctx, strings.Join(c, ","), token)
+		if videos != nil {
+			net.videos = append(net.videos, videos...)
 		} else {
-			fmt.Printf("processed channel: %v, id: %v \n", ch.Items[0].Snippet.Title, &channelId.RawId)
+			log.Println("Can't get video from api")
 		}
+	}
+	if channelId.isPlSync {
+		net.playlists = GetPlaylists(ctx, channelId.Id, token)
+		net.fetchUnlisted(ctx, token, channelId.Id, net.fetchPlaylistVidIds(ctx, token, slices.Concat(channelId.vidIds, newVidIds)))
+	}
+	net.fetchDetails(ctx, token, channelId.Id)
 
-		resArtist := &artist.Artist{
-			SiteId:    siteId,
-			ArtistId:  channelId.Id,
-			Title:     ch.Items[0].Snippet.Title,
-			Thumbnail: chThumb,
+	txMu.Lock()
+	defer txMu.Unlock()
+	tx, err := db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelSerializable})
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	// дропнем признаки предыдущей синхронизации
+	stVidUpd, _ := tx.PrepareContext(ctx, "update main.video set syncState = 0 where video.vid_id in (select v.vid_id from main.video v join main.playlistVideo pV on v.vid_id = pV.videoId where v.syncState = 1 and pV.playlistId = ?);")
+
+	defer func(stVidUpd *sql.Stmt) {
+		err = stVidUpd.Close()
+		if err != nil {
+			log.Println(err)
+		}
+	}(stVidUpd)
+
+	_, err = stVidUpd.ExecContext(ctx, channelId.RawPlId)
+	if err != nil {
+		log.Println(err)
+	}
+
+	if full {
+		setChannelFullSyncDb(tx, ctx, channelId.RawId)
+	}
+
+	resArtist := &artist.Artist{
+		SiteId:   siteId,
+		ArtistId: channelId.Id,
+		NewAlbs:  int32(len(newVidIds)),
+	}
+
+	if len(net.videos) > 0 {
+		processVideos(ctx, tx, net.videos, net.shorts, resArtist, channelId.RawPlId, channelId.Id, 1, 0)
+	}
+
+	if channelId.isPlSync {
+		stPlRem, er := tx.PrepareContext(ctx, "delete from main.playlist where pl_id in (select cp.playlistId from main.channelPlaylist cp inner join main.playlist p on cp.playlistId = p.pl_id where cp.channelId = ? and p.playlistType = 1);")
+		if er != nil {
+			log.Println(er)
+		}
+		defer func(stPlRem *sql.Stmt) {
+			err = stPlRem.Close()
+			if err != nil {
+				log.Println(err)
+			}
+		}(stPlRem)
+
+		_, er = stPlRem.ExecContext(ctx, channelId.RawId)
+		if er != nil {
+			log.Println(er)
 		}
 
 		stPlaylist, er := tx.PrepareContext(ctx, "insert into main.playlist(playlistId,title,playlistType,thumbnail) values (?,?,?,?) on conflict (playlistId, title) do nothing returning pl_id;")
@@ -145,9 +437,9 @@ func SyncArtistYou(ctx context.Context, siteId uint32, channelId ArtistRawId, is
 			log.Println(er)
 		}
 		defer func(stPlaylist *sql.Stmt) {
-			er = stPlaylist.Close()
-			if er != nil {
-				log.Println(er)
+			err = stPlaylist.Close()
+			if err != nil {
+				log.Println(err)
 			}
 		}(stPlaylist)
 
@@ -156,57 +448,40 @@ func SyncArtistYou(ctx context.Context, siteId uint32, channelId ArtistRawId, is
 			log.Println(er)
 		}
 		defer func(stChPl *sql.Stmt) {
-			er = stChPl.Close()
-			if er != nil {
-				log.Println(er)
+			err = stChPl.Close()
+			if err != nil {
+				log.Println(err)
 			}
 		}(stChPl)
 
-		allPl := GetPlaylists(ctx, channelId.Id, token)
-
-		allPl = append(allPl, &plItem{
-			id:        ch.Items[0].ContentDetails.RelatedPlaylists.Uploads,
-			title:     "Uploads",
-			typePl:    0,
-			thumbnail: chThumb,
-		})
-
-		for i, item := range allPl {
+		for _, item := range net.playlists {
 			var plId int
-			insEr := stPlaylist.QueryRowContext(ctx, item.id, ClearString(item.title), item.typePl, item.thumbnail).Scan(&plId)
+			insEr := stPlaylist.QueryRowContext(ctx, item.id, item.title, item.typePl, item.thumbnail).Scan(&plId)
 			if insEr != nil {
 				log.Println(insEr)
 			} else {
 				fmt.Printf("processed playlist: %v, id: %v \n", item.id, plId)
 				item.rawId = plId
-				_, er = stChPl.ExecContext(ctx, &channelId.RawId, plId)
+				_, er = stChPl.ExecContext(ctx, channelId.RawId, plId)
 				if er != nil {
 					log.Println(er)
-				} else if i == len(allPl)-1 {
-					channelId.RawPlId = plId
 				}
 			}
 		}
 
-		uploadPl := allPl[len(allPl)-1]
-		videos := GetUploadVid(ctx, uploadPl.id, token)
-		var uploadVidIds map[string]int
-		if videos != nil {
-			uploadVidIds = processVideos(ctx, tx, videos, resArtist, uploadPl.rawId, channelId.Id, 0, 0)
-		} else {
-			log.Println("can't get video from api")
+		uploadVidIds, er := getChannelVideosIdsFromDb(ctx, tx, channelId.RawPlId)
+		if er != nil {
+			log.Println(er)
 		}
-
-		var notUploadId []string
-		for _, pl := range allPl[:len(allPl)-1] {
-			netPlIds := GetPlaylistVidIds(ctx, pl.id, token)
+		for _, pl := range net.playlists {
+			netPlIds, ok := net.plVidIds[pl.id]
+			if !ok {
+				continue
+			}
 			plVid := make(map[string]int)
 			for _, vId := range netPlIds {
-				rawVidId, ok := uploadVidIds[vId]
-				if ok {
+				if rawVidId, ok := uploadVidIds[vId]; ok {
 					plVid[vId] = rawVidId
-				} else if !slices2.Contains(notUploadId, vId) {
-					notUploadId = append(notUploadId, vId)
 				}
 			}
 			if len(plVid) == 0 {
@@ -214,144 +489,59 @@ func SyncArtistYou(ctx context.Context, siteId uint32, channelId ArtistRawId, is
 			} else {
 				insertPlaylistVideoIds(ctx, tx, plVid, pl.rawId)
 			}
-			resArtist.Playlists = append(resArtist.Playlists, &artist.Playlist{
-				PlaylistId:   pl.id,
-				Title:        ClearString(pl.title),
-				PlaylistType: 2,
-				Thumbnail:    pl.thumbnail,
-				VideoIds:     netPlIds,
-			})
 		}
 
-		insertUnlisted(ctx, tx, notUploadId, token, channelId, resArtist, 0)
-
-		return resArtist, tx.Commit()
+		insertUnlisted(ctx, tx, net, channelId, resArtist, 1)
+	}
+	setLastSyncDb(tx, ctx, siteId, channelId.RawId)
 
-	} else {
-		// синк
-		// дропнем признаки предыдущей синхронизации
-		stVidUpd, _ := tx.PrepareContext(ctx, "update main.video set syncState = 0 where video.vid_id in (select v.vid_id from main.video v join main.playlistVideo pV on v.vid_id = pV.videoId where v.syncState = 1 and pV.playlistId = ?);")
+	return resArtist, tx.Commit()
+}
 
-		defer func(stVidUpd *sql.Stmt) {
-			err = stVidUpd.Close()
-			if err != nil {
+// getNetVidIds takes uploads from the channel feed, it costs no quota. The whole uploads playlist is read
+// when playlists are synced too, the channel is due a full sync, the feed fails or has none of the known
+// videos, so more than a feed worth of uploads may be missing. Near the quota budget a due full sync waits
+// for the next day. The flag is true when the playlist was read.
+func getNetVidIds(ctx context.Context, channelId ArtistRawId, token string, due bool) ([]string, bool, error) {
+	if len(channelId.vidIds) > 0 && !channelId.isPlSync {
+		if due && quota.near() {
+			fmt.Printf("channelId: %v, quota is near the budget, full sync deferred\n", channelId.Id)
+			due = false
+		}
+		if !due {
+			feedIds, err := GetFeedVidIds(ctx, channelId.Id)
+			switch {
+			case err != nil:
 				log.Println(err)
-			}
-		}(stVidUpd)
-
-		_, err = stVidUpd.ExecContext(ctx, channelId.RawPlId)
-		if err != nil {
-			log.Println(err)
-		}
-
-		// получим актуальные айдишники из апи
-		netIds := GetPlaylistVidIds(ctx, channelId.PlaylistId, token)
-		// сравним
-		newVidIds := FindDifference(netIds, channelId.vidIds)
-		fmt.Printf("siteId: %v, channelId: %d, new videos: %d\n", siteId, channelId.RawId, len(newVidIds))
-
-		resArtist := &artist.Artist{
-			SiteId:   siteId,
-			ArtistId: channelId.Id,
-			NewAlbs:  int32(len(newVidIds)),
-		}
-
-		for c := range slices.Chunk(newVidIds, 50) {
-			var sb strings.Builder
-			for _, vid := range c {
-				sb.WriteString(vid + ",")
-			}
-			videos := GetVidByIds(ctx, strings.TrimRight(sb.String(), ","), token)
-			if videos != nil {
-				processVideos(ctx, tx, videos, resArtist, channelId.RawPlId, channelId.Id, 1, 0)
-			} else {
-				log.Println("Can't get video from api")
+			case len(FindDifference(feedIds, channelId.vidIds)) < len(feedIds):
+				return feedIds, false, nil
+			default:
+				fmt.Printf("channelId: %v, feed has no known videos, read the playlist\n", channelId.Id)
 			}
 		}
+	}
 
-		if channelId.isPlSync {
-			stPlRem, er := tx.PrepareContext(ctx, "delete from main.playlist where pl_id in (select cp.playlistId from main.channelPlaylist cp inner join main.playlist p on cp.playlistId = p.pl_id where cp.channelId = ? and p.playlistType = 1);")
-			if er != nil {
-				log.Println(er)
-			}
-			defer func(stPlRem *sql.Stmt) {
-				err = stPlRem.Close()
-				if err != nil {
-					log.Println(err)
-				}
-			}(stPlRem)
-
-			_, er = stPlRem.ExecContext(ctx, channelId.RawId)
-			if er != nil {
-				log.Println(er)
-			}
-
-			stPlaylist, er := tx.PrepareContext(ctx, "insert into main.playlist(playlistId,title,playlistType,thumbnail) values (?,?,?,?) on conflict (playlistId, title) do nothing returning pl_id;")
-			if er != nil {
-				log.Println(er)
-			}
-			defer func(stPlaylist *sql.Stmt) {
-				err = stPlaylist.Close()
-				if err != nil {
-					log.Println(err)
-				}
-			}(stPlaylist)
+	netIds, err := GetPlaylistVidIds(ctx, channelId.PlaylistId, token)
+	return netIds, err == nil, err
+}
 
-			stChPl, er := tx.PrepareContext(ctx, "insert into main.channelPlaylist(channelId, playlistId) values (?,?) on conflict do nothing;")
-			if er != nil {
-				log.Println(er)
-			}
-			defer func(stChPl *sql.Stmt) {
-				err = stChPl.Close()
-				if err != nil {
-					log.Println(err)
-				}
-			}(stChPl)
-
-			allPl := GetPlaylists(ctx, channelId.Id, token)
-
-			for _, item := range allPl {
-				var plId int
-				insEr := stPlaylist.QueryRowContext(ctx, item.id, item.title, item.typePl, item.thumbnail).Scan(&plId)
-				if insEr != nil {
-					log.Println(insEr)
-				} else {
-					fmt.Printf("processed playlist: %v, id: %v \n", item.id, plId)
-					item.rawId = plId
-					_, er = stChPl.ExecContext(ctx, channelId.RawId, plId)
-					if er != nil {
-						log.Println(er)
-					}
-				}
-			}
+// needChannelFullSyncDb is true when the uploads playlist was never read or was read more than FullSyncDays ago
+func needChannelFullSyncDb(tx *sql.Tx, ctx context.Context, chId int) bool {
+	var need bool
 
-			uploadVidIds, er := getChannelVideosIdsFromDb(ctx, tx, channelId.RawPlId)
-			if er != nil {
-				log.Println(er)
-			}
-			var notUploadId []string
-			for _, pl := range allPl {
-				netPlIds := GetPlaylistVidIds(ctx, pl.id, token)
-				plVid := make(map[string]int)
-				for _, vId := range netPlIds {
-					rawVidId, ok := uploadVidIds[vId]
-					if ok {
-						plVid[vId] = rawVidId
-					} else {
-						notUploadId = append(notUploadId, vId)
-					}
-				}
-				if len(plVid) == 0 {
-					deletePlaylistById(ctx, tx, pl.rawId)
-				} else {
-					insertPlaylistVideoIds(ctx, tx, plVid, pl.rawId)
-				}
-			}
+	err := tx.QueryRowContext(ctx, "select lastFullSync is null or lastFullSync < datetime('now', ?) from main.channel where ch_id = ?;", fmt.Sprintf("-%d days", FullSyncDays), chId).Scan(&need)
+	if err != nil {
+		log.Println(err)
+		return true
+	}
 
-			insertUnlisted(ctx, tx, notUploadId, token, channelId, resArtist, 1)
-		}
+	return need
+}
 
-		return resArtist, tx.Commit()
+func setChannelFullSyncDb(tx *sql.Tx, ctx context.Context, chId int) {
+	_, err := tx.ExecContext(ctx, "update main.channel set lastFullSync = datetime('now') where ch_id = ?;", chId)
+	if err != nil {
+		log.Println(err)
 	}
 }
 
@@ -366,7 +556,7 @@ func GetChannels(ctx context.Context, siteId uint32) ([]*artist.Artist, error) {
 
 	var arts []*artist.Artist
 
-	stmt, err := db.PrepareContext(ctx, "select ch.ch_id, ch.channelId, ch.title, ch.thumbnail from main.channel ch where ch.siteId = ? group by ch.ch_id order by 3;")
+	stmt, err := db.PrepareContext(ctx, "select ch.ch_id, ch.channelId, ch.title, ch.thumbnail, ch.skipSync, ch.syncInterval, ch.priority, ifnull(ch.lastSync,'') from main.channel ch where ch.siteId = ? group by ch.ch_id order by 3;")
 	if err != nil {
 		log.Println(err)
 	}
@@ -425,8 +615,8 @@ func GetChannels(ctx context.Context, siteId uint32) ([]*artist.Artist, error) {
 	}
 
 	for rows.Next() {
-		var art artist.Artist
-		if e := rows.Scan(&art.Id, &art.ArtistId, &art.Title, &art.Thumbnail); e != nil {
+		art := artist.Artist{Sync: &artist.SyncSettings{}}
+		if e := rows.Scan(&art.Id, &art.ArtistId, &art.Title, &art.Thumbnail, &art.Sync.Paused, &art.Sync.IntervalHours, &art.Sync.Priority, &art.Sync.LastSync); e != nil {
 			log.Println(e)
 		} else {
 			art.SiteId = siteId
@@ -441,7 +631,7 @@ func GetChannels(ctx context.Context, siteId uint32) ([]*artist.Artist, error) {
 	return arts, err
 }
 
-func GetChannelVideosFromDb(ctx context.Context, siteId uint32, channelId string) ([]*artist.Album, []*artist.Playlist, error) {
+func GetChannelVideosFromDb(ctx context.Context, siteId uint32, channelId string, f *albumFilter) ([]*artist.Album, []*artist.Playlist, string, error) {
 	db, err := sql.Open(sqlite3, fmt.Sprintf("file:%v?cache=shared&mode=ro", dbFile))
 	if err != nil {
 		log.Println(err)
@@ -453,7 +643,11 @@ func GetChannelVideosFromDb(ctx context.Context, siteId uint32, channelId string
 		}
 	}(db)
 
-	stRows, err := db.PrepareContext(ctx, "select v.vid_id, v.title, v.videoId, v.duration, v.timestamp, v.likeCount, v.viewCount, v.thumbnail, v.syncState, v.listState, v.watchState, ifnull(v.quality,0) as quality from main.video v join main.playlistVideo pV on v.vid_id = pV.videoId where pV.playlistId = (select pl_id from main.playlist join main.channelPlaylist cP on playlist.pl_id = cP.playlistId where cP.channelId = (select ch_id from main.channel where channelId = ? and siteId = ? limit 1) and playlistType = 0 limit 1) order by 9 desc, 5 desc;")
+	cond, args := f.where("v.timestamp", "3")
+	kindCond, kindArgs := f.whereKinds("v.kind")
+	cond += kindCond
+	args = append(args, kindArgs...)
+	stRows, err := db.PrepareContext(ctx, "select v.vid_id, v.title, v.videoId, v.duration, v.timestamp, v.likeCount, v.viewCount, v.thumbnail, v.syncState, v.listState, v.watchState, ifnull(v.quality,0) as quality, v.kind from main.video v join main.playlistVideo pV on v.vid_id = pV.videoId where pV.playlistId = (select pl_id from main.playlist join main.channelPlaylist cP on playlist.pl_id = cP.playlistId where cP.channelId = (select ch_id from main.channel where channelId = ? and siteId = ? limit 1) and playlistType = 0 limit 1)"+cond+f.orderBy("9 desc, 5 desc", "1", sortColumns[siteYoutube])+f.limit()+";")
 	if err != nil {
 		log.Println(err)
 	}
@@ -464,7 +658,7 @@ func GetChannelVideosFromDb(ctx context.Context, siteId uint32, channelId string
 		}
 	}(stRows)
 
-	rows, err := stRows.QueryContext(ctx, channelId, siteId)
+	rows, err := stRows.QueryContext(ctx, append([]interface{}{channelId, siteId}, args...)...)
 	if err != nil {
 		log.Println(err)
 	}
@@ -475,12 +669,20 @@ func GetChannelVideosFromDb(ctx context.Context, siteId uint32, channelId string
 		}
 	}(rows)
 
-	var albs []*artist.Album
+	var (
+		albs    []*artist.Album
+		scanned int
+	)
 
 	for rows.Next() {
+		scanned++
+		if f.pastPage(scanned) {
+			// лишняя строка только говорит, что есть следующая страница
+			break
+		}
 		var alb artist.Album
 
-		if err = rows.Scan(&alb.Id, &alb.Title, &alb.AlbumId, &alb.SubTitle, &alb.ReleaseDate, &alb.LikeCount, &alb.ViewCount, &alb.Thumbnail, &alb.SyncState, &alb.ListState, &alb.WatchState, &alb.Quality); err != nil {
+		if err = rows.Scan(&alb.Id, &alb.Title, &alb.AlbumId, &alb.SubTitle, &alb.ReleaseDate, &alb.LikeCount, &alb.ViewCount, &alb.Thumbnail, &alb.SyncState, &alb.ListState, &alb.WatchState, &alb.Quality, &alb.Kind); err != nil {
 			log.Println(err)
 		} else {
 			date, er := time.Parse(time.DateTime, alb.ReleaseDate)
@@ -501,6 +703,12 @@ func GetChannelVideosFromDb(ctx context.Context, siteId uint32, channelId string
 		}
 	}
 
+	albs, nextToken := page(f, albs, scanned)
+	if !f.isFirstPage() {
+		// плейлисты уже ушли с первой страницей
+		return albs, nil, nextToken, err
+	}
+
 	stPlRows, err := db.PrepareContext(ctx, "select p.pl_id, p.playlistId, p.title, p.playlistType, p.thumbnail, group_concat(v.videoId, ',') from channelPlaylist inner join main.playlist p on channelPlaylist.playlistId = p.pl_id inner join main.playlistVideo pV on channelPlaylist.playlistId = pV.playlistId inner join main.video v on v.vid_id = pV.videoId inner join channel c on c.ch_id = channelPlaylist.channelId where c.channelId = ? and c.siteId = ? group by p.pl_id;")
 	if err != nil {
 		log.Println(err)
@@ -539,7 +747,7 @@ func GetChannelVideosFromDb(ctx context.Context, siteId uint32, channelId string
 		}
 	}
 
-	return albs, pls, err
+	return albs, pls, nextToken, err
 }
 
 func GetChannelVideosIdFromDb(ctx context.Context, siteId uint32, channelId string, newOnly bool) ([]string, error) {
@@ -606,7 +814,7 @@ func GetChannelVideosIdFromDb(ctx context.Context, siteId uint32, channelId stri
 	return albIds, err
 }
 
-func GetNewVideosFromDb(ctx context.Context) ([]*artist.Album, []*artist.Playlist, error) {
+func GetNewVideosFromDb(ctx context.Context, f *albumFilter) ([]*artist.Album, []*artist.Playlist, string, error) {
 	db, err := sql.Open(sqlite3, fmt.Sprintf("file:%v?cache=shared&mode=ro", dbFile))
 	if err != nil {
 		log.Println(err)
@@ -619,7 +827,11 @@ func GetNewVideosFromDb(ctx context.Context) ([]*artist.Album, []*artist.Playlis
 	}(db)
 
 	// stRows, err := db.PrepareContext(ctx, "select v.vid_id, v.title, v.videoId, v.duration, v.timestamp, v.likeCount, v.viewCount, v.thumbnail, v.syncState, v.listState, ifnull(v.quality,0), c.channelId from main.video v inner join main.playlistVideo pV on v.vid_id = pV.videoId inner join main.playlist p on p.pl_id = pV.playlistId inner join main.channelPlaylist cP on p.pl_id = cP.playlistId inner join main.channel c on c.ch_id = cP.channelId where v.syncState = 1 and p.playlistType = 0 and c.siteId = ? order by 5 desc;")
-	stRows, err := db.PrepareContext(ctx, "select v.vid_id, v.title, v.videoId, v.duration, v.timestamp, v.likeCount, v.viewCount, v.thumbnail, v.syncState, v.listState, v.watchState, ifnull(v.quality,0) from main.video v where v.syncState = 1 or v.watchState = 1 order by 5 desc;")
+	cond, args := f.where("v.timestamp", "3")
+	kindCond, kindArgs := f.whereKinds("v.kind")
+	cond += kindCond
+	args = append(args, kindArgs...)
+	stRows, err := db.PrepareContext(ctx, "select v.vid_id, v.title, v.videoId, v.duration, v.timestamp, v.likeCount, v.viewCount, v.thumbnail, v.syncState, v.listState, v.watchState, ifnull(v.quality,0), v.kind from main.video v where v.syncState = 1"+cond+f.orderBy("5 desc", "1", sortColumns[siteYoutube])+f.limit()+";")
 	if err != nil {
 		log.Println(err)
 	}
@@ -630,7 +842,7 @@ func GetNewVideosFromDb(ctx context.Context) ([]*artist.Album, []*artist.Playlis
 		}
 	}(stRows)
 
-	rows, err := stRows.QueryContext(ctx)
+	rows, err := stRows.QueryContext(ctx, args...)
 	if err != nil {
 		log.Println(err)
 	}
@@ -641,29 +853,29 @@ func GetNewVideosFromDb(ctx context.Context) ([]*artist.Album, []*artist.Playlis
 		}
 	}(rows)
 
-	var albs []*artist.Album
-
-	var vidIs []string
+	var (
+		albs    []*artist.Album
+		scanned int
+	)
 
 	for rows.Next() {
+		scanned++
+		if f.pastPage(scanned) {
+			// лишняя строка только говорит, что есть следующая страница
+			break
+		}
 		var (
 			alb      artist.Album
 			parentId string
 		)
 
-		if err = rows.Scan(&alb.Id, &alb.Title, &alb.AlbumId, &alb.SubTitle, &alb.ReleaseDate, &alb.LikeCount, &alb.ViewCount, &alb.Thumbnail, &alb.SyncState, &alb.ListState, &alb.WatchState, &alb.Quality); err != nil {
+		if err = rows.Scan(&alb.Id, &alb.Title, &alb.AlbumId, &alb.SubTitle, &alb.ReleaseDate, &alb.LikeCount, &alb.ViewCount, &alb.Thumbnail, &alb.SyncState, &alb.ListState, &alb.WatchState, &alb.Quality, &alb.Kind); err != nil {
 			log.Println(err)
 		} else {
 			date, er := time.Parse(time.DateTime, alb.ReleaseDate)
 			if er != nil {
 				log.Println(er)
 			} else {
-				if alb.GetSyncState() != 1 {
-					if alb.GetWatchState() == 1 {
-						vidIs = append(vidIs, alb.GetAlbumId())
-					}
-					continue
-				}
 				alb.SubTitle = fmt.Sprintf("%s   %s   Views: %d   Likes: %d", alb.GetSubTitle(), TimeAgo(date), alb.GetViewCount(), alb.GetLikeCount())
 				if alb.GetListState() == 1 {
 					alb.SubTitle += "   ®"
@@ -678,6 +890,17 @@ func GetNewVideosFromDb(ctx context.Context) ([]*artist.Album, []*artist.Playlis
 		}
 	}
 
+	albs, nextToken := page(f, albs, scanned)
+	if !f.isFirstPage() {
+		return albs, nil, nextToken, err
+	}
+
+	// отложенные, но уже не новые видео отдадим отдельным плейлистом
+	vidIs, err := getPlannedVideoIdsDb(ctx, db)
+	if err != nil {
+		log.Println(err)
+	}
+
 	var pls []*artist.Playlist
 
 	if vidIs != nil {
@@ -691,7 +914,33 @@ func GetNewVideosFromDb(ctx context.Context) ([]*artist.Album, []*artist.Playlis
 		})
 	}
 
-	return albs, pls, err
+	return albs, pls, nextToken, err
+}
+
+func getPlannedVideoIdsDb(ctx context.Context, db *sql.DB) ([]string, error) {
+	rows, err := db.QueryContext(ctx, "select v.videoId from main.video v where v.watchState = 1 and v.syncState != 1 order by v.timestamp desc;")
+	if err != nil {
+		return nil, err
+	}
+	defer func(rows *sql.Rows) {
+		err = rows.Close()
+		if err != nil {
+			log.Println(err)
+		}
+	}(rows)
+
+	var vidIds []string
+
+	for rows.Next() {
+		var vidId string
+		if err = rows.Scan(&vidId); err != nil {
+			log.Println(err)
+		} else {
+			vidIds = append(vidIds, vidId)
+		}
+	}
+
+	return vidIds, rows.Err()
 }
 
 func SetPlannedDb(ctx context.Context, videoId string, state uint32) (int64, error) {
@@ -782,18 +1031,52 @@ func DeleteChannelDb(ctx context.Context, siteId uint32, artistId []string) (int
 	return deletedRowCount, tx.Commit()
 }
 
-func DownloadVideos(ctx context.Context, vidIds []string, quality string, isPl bool) (map[string]string, error) {
+func DownloadVideos(ctx context.Context, vidIds []string, quality string, isPl bool, progress DownloadProgress) (map[string]string, error) {
+	if progress != nil {
+		report := progress
+		progress = func(event *artist.DownloadEvent) {
+			event.SiteId = 4
+			report(event)
+		}
+	}
 	mDownloaded := make(map[string]string)
 
-	for _, id := range vidIds {
+	for i, id := range vidIds {
+		res := strings.Split(id, ";")
+		if len(res) != 2 {
+			continue
+		}
+		progress.emit(&artist.DownloadEvent{
+			EventType:  downloadEventQueued,
+			AlbumId:    res[0],
+			TrackId:    res[1],
+			TrackNum:   int32(i + 1),
+			TrackTotal: int32(len(vidIds)),
+			Quality:    quality,
+		})
+	}
+
+	for i, id := range vidIds {
 		res := strings.Split(id, ";")
 		if len(res) != 2 {
 			log.Println("Invalid ui param:", id)
+			progress.emit(&artist.DownloadEvent{EventType: downloadEventFailed, TrackId: id, Error: "invalid ui param"})
 			continue
 		}
 		chId := res[0]
 		videoId := res[1]
 
+		event := func(eventType int32) *artist.DownloadEvent {
+			return &artist.DownloadEvent{
+				EventType:  eventType,
+				AlbumId:    chId,
+				TrackId:    videoId,
+				TrackNum:   int32(i + 1),
+				TrackTotal: int32(len(vidIds)),
+				Quality:    quality,
+			}
+		}
+
 		mChannel := make(map[string]string)
 		absChannelName, exist := mChannel[chId]
 		if !exist {
@@ -804,17 +1087,39 @@ func DownloadVideos(ctx context.Context, vidIds []string, quality string, isPl b
 			err := os.MkdirAll(absChannelName, 0o755)
 			if err != nil {
 				log.Println(chId+" can't create folder.", err)
+				failed := event(downloadEventFailed)
+				failed.Error = fmt.Sprintf("can't create folder: %v", err)
+				progress.emit(failed)
 				continue
 			}
 			mChannel[chId] = absChannelName
 		}
 
-		resDown, err := DownloadVideo(ctx, absChannelName, videoId, quality, isPl)
+		progress.emit(event(downloadEventStarted))
+		var vidProgress DownloadProgress
+		if progress != nil {
+			vidProgress = func(e *artist.DownloadEvent) {
+				e.AlbumId = chId
+				e.TrackNum = int32(i + 1)
+				e.TrackTotal = int32(len(vidIds))
+				progress.emit(e)
+			}
+		}
+
+		resDown, err := DownloadVideo(ctx, absChannelName, videoId, quality, isPl, vidProgress)
 		if err != nil {
 			log.Println(videoId+" something was wrong.", err)
+			failed := event(downloadEventFailed)
+			failed.Error = err.Error()
+			progress.emit(failed)
+			if ctx.Err() != nil {
+				// отменили, остальные видео не качаем
+				return mDownloaded, ctx.Err()
+			}
 			continue
 		} else {
 			mDownloaded[id] = resDown
+			progress.emit(event(downloadEventFinished))
 		}
 	}
 
@@ -860,6 +1165,57 @@ func ClearVidSyncStateDb(ctx context.Context, siteId uint32) (int64, error) {
 	return aff, tx.Commit()
 }
 
+// AckVidSyncStateDb сбрасывает признак новизны только у части видео и возвращает, сколько новых осталось
+func AckVidSyncStateDb(ctx context.Context, siteId uint32, ack *acknowledge) (int64, int64, error) {
+	db, err := sql.Open(sqlite3, fmt.Sprintf("file:%v?_foreign_keys=false&cache=shared&mode=rw", dbFile))
+	if err != nil {
+		log.Println(err)
+	}
+	defer func(db *sql.DB) {
+		err = db.Close()
+		if err != nil {
+			log.Println(err)
+		}
+	}(db)
+
+	tx, err := db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelSerializable})
+	if err != nil {
+		log.Println(err)
+	}
+
+	cond, args := ack.where("v.videoId", "c.channelId", "v.timestamp")
+	stRows, err := tx.PrepareContext(ctx, "update main.video set syncState = 0 where video.vid_id in (select v.vid_id from video v inner join main.playlistVideo pV on v.vid_id = pV.videoId inner join main.playlist p on pV.playlistId = p.pl_id inner join channelPlaylist cP on p.pl_id = cP.playlistId inner join channel c on c.ch_id = cP.channelId where c.siteId = ? and p.playlistType = 0 and v.syncState = 1"+cond+");")
+	if err != nil {
+		log.Println(err)
+		return 0, 0, rollbackWith(tx, err)
+	}
+	defer func(stRows *sql.Stmt) {
+		err = stRows.Close()
+		if err != nil {
+			log.Println(err)
+		}
+	}(stRows)
+
+	rows, err := stRows.ExecContext(ctx, append([]interface{}{siteId}, args...)...)
+	if err != nil {
+		log.Println(err)
+		return 0, 0, rollbackWith(tx, err)
+	}
+	aff, err := rows.RowsAffected()
+	if err != nil {
+		log.Println(err)
+	}
+
+	var remaining int64
+	err = tx.QueryRowContext(ctx, "select count(distinct v.vid_id) from video v inner join main.playlistVideo pV on v.vid_id = pV.videoId inner join main.playlist p on pV.playlistId = p.pl_id inner join channelPlaylist cP on p.pl_id = cP.playlistId inner join channel c on c.ch_id = cP.channelId where c.siteId = ? and p.playlistType = 0 and v.syncState = 1;", siteId).Scan(&remaining)
+	if err != nil {
+		log.Println(err)
+		return 0, 0, rollbackWith(tx, err)
+	}
+
+	return aff, remaining, tx.Commit()
+}
+
 func getChannelIdDb(tx *sql.Tx, ctx context.Context, siteId uint32, channelId interface{}, syncPls bool) ArtistRawId {
 	stmtArt, err := tx.PrepareContext(ctx, "select c.ch_id, c.channelId, p.pl_id, p.playlistId, group_concat(v.videoId, ',') from main.video v inner join main.playlistVideo pV on v.vid_id = pV.videoId inner join main.playlist p on p.pl_id = pV.playlistId inner join main.channelPlaylist cP on p.pl_id = cP.playlistId inner join main.channel c on c.ch_id = cP.channelId where c.channelId = ? and siteId = ? and p.playlistType = 0 limit 1;")
 	if err != nil {
@@ -896,32 +1252,94 @@ func getChannelIdDb(tx *sql.Tx, ctx context.Context, siteId uint32, channelId in
 	return artId
 }
 
-func insertUnlisted(ctx context.Context, tx *sql.Tx, notUploadId []string, token string, channelId ArtistRawId, resArtist *artist.Artist, syncState int32) {
-	for c := range slices.Chunk(notUploadId, 50) {
-		var sb strings.Builder
-		for _, vid := range c {
-			sb.WriteString(vid + ",")
-		}
-		notListed := strings.TrimRight(sb.String(), ",")
-		fmt.Println("found unlisted video(s): " + notListed)
-		chVideosIds, e := GetChannelIdsByVid(ctx, token, notListed, channelId.Id)
-		if e != nil {
-			log.Println(e)
-		} else if chVideosIds != "" {
-			fmt.Println("processable unlisted video(s): " + chVideosIds)
-			unlistedVideos := GetVidByIds(ctx, chVideosIds, token)
-			if unlistedVideos != nil {
-				resRaw := processVideos(ctx, tx, unlistedVideos, resArtist, channelId.RawPlId, channelId.Id, syncState, 1)
-				fmt.Printf("insert unlisted video(s): %v \n", len(resRaw))
-			} else {
-				log.Println("can't get unlisted video from api")
-			}
-		}
+func insertUnlisted(ctx context.Context, tx *sql.Tx, net *channelNet, channelId ArtistRawId, resArtist *artist.Artist, syncState int32) {
+	if len(net.unlisted) == 0 {
+		return
 	}
+	resRaw := processVideos(ctx, tx, net.unlisted, net.shorts, resArtist, channelId.RawPlId, channelId.Id, syncState, 1)
+	fmt.Printf("insert unlisted video(s): %v \n", len(resRaw))
 }
 
-func processVideos(ctx context.Context, tx *sql.Tx, videos []*vidItem, resArtist *artist.Artist, plId int, channelId string, syncState int32, listState int32) map[string]int {
-	stVideo, err := tx.PrepareContext(ctx, "insert into main.video(videoId, title, timestamp, duration, likeCount, viewCount, commentCount, syncState, listState, thumbnail) values (?,?,?,?,?,?,?,?,?,?) on conflict (videoId, title) do update set syncState = 1 returning vid_id;")
+const (
+	videoKindRegular int32 = iota
+	videoKindShort
+	videoKindLive
+	videoKindPremiere
+)
+
+// premiereGap: стрим публикуется в момент начала эфира, премьера заранее, когда залит файл
+const premiereGap = time.Minute
+
+const (
+	// шортсы бывают до 3 минут, но до минуты почти все ролики шортсы
+	shortMaxSec  = 180
+	shortSureSec = 60
+)
+
+// getShortIds reads the first page of the shorts playlist of the channel, UUSH instead of UC in the id.
+// It is only asked when a new video is too long to be sure it is a short by duration alone.
+func getShortIds(ctx context.Context, token string, channelId string, videos []*vidItem) map[string]bool {
+	if !strings.HasPrefix(channelId, "UC") {
+		return nil
+	}
+	need := slices.ContainsFunc(videos, func(vid *vidItem) bool {
+		sec := youtubeDurationSec(vid.duration)
+		return !vid.isLive && sec > shortSureSec && sec <= shortMaxSec
+	})
+	if !need {
+		return nil
+	}
+
+	url := strings.Replace(strings.Replace(playlistIdsString, "[ID]", "UUSH"+strings.TrimPrefix(channelId, "UC"), 1), "[KEY]", token, 1)
+	upl, err := geUploadIds(ctx, url)
+	if err != nil {
+		// у канала без шортсов плейлиста нет, это 404
+		log.Println(err)
+		return nil
+	}
+
+	shorts := make(map[string]bool)
+	for _, item := range upl.Items {
+		shorts[item.Snippet.ResourceID.VideoID] = true
+	}
+	return shorts
+}
+
+// videoKind tells streams from premieres: an upcoming stream has no duration yet while a premiere has
+// the uploaded file, an aired premiere started well after it was published while a stream is published
+// when it starts. Shorts are found in the shorts playlist, the short ones by duration.
+func videoKind(vid *vidItem, shorts map[string]bool) int32 {
+	sec := youtubeDurationSec(vid.duration)
+	switch {
+	case vid.isLive && vid.broadcast == "upcoming" && sec > 0, vid.isLive && airedPremiere(vid):
+		return videoKindPremiere
+	case vid.isLive:
+		return videoKindLive
+	case shorts[vid.id], sec > 0 && sec <= shortSureSec:
+		return videoKindShort
+	default:
+		return videoKindRegular
+	}
+}
+
+func airedPremiere(vid *vidItem) bool {
+	if vid.started == "" {
+		return false
+	}
+	started, err := time.Parse(time.RFC3339, vid.started)
+	if err != nil {
+		return false
+	}
+	published, err := time.Parse(time.DateTime, vid.published)
+	if err != nil {
+		return false
+	}
+	return started.Sub(published) > premiereGap
+}
+
+func processVideos(ctx context.Context, tx *sql.Tx, videos []*vidItem, shorts map[string]bool, resArtist *artist.Artist, plId int, channelId string, syncState int32, listState int32) map[string]int {
+	// kind 3 премьера: увиденную до эфира премьеру не превращаем в стрим
+	stVideo, err := tx.PrepareContext(ctx, "insert into main.video(videoId, title, timestamp, duration, likeCount, viewCount, commentCount, syncState, listState, thumbnail, kind) values (?,?,?,?,?,?,?,?,?,?,?) on conflict (videoId, title) do update set syncState = 1, kind = case when kind = 3 then kind else excluded.kind end returning vid_id;")
 	if err != nil {
 		log.Println(err)
 	}
@@ -932,13 +1350,21 @@ func processVideos(ctx context.Context, tx *sql.Tx, videos []*vidItem, resArtist
 		}
 	}(stVideo)
 
-	mVidRawIds := make(map[string]int)
-	for _, vid := range videos {
-		vThumb := GetThumb(ctx, vid.thumbnailLink)
-		if vThumb != nil {
-			vThumb = PrepareThumb(vThumb, 15, 64, 64, 90)
+	// первое чтение счетчиков, дальше их пополняет RefreshVideoStats
+	stStats, err := tx.PrepareContext(ctx, "insert into main.videoStats(videoId, viewCount, likeCount, commentCount) values (?,?,?,?);")
+	if err != nil {
+		log.Println(err)
+	}
+	defer func(stStats *sql.Stmt) {
+		err = stStats.Close()
+		if err != nil {
+			log.Println(err)
 		}
+	}(stStats)
 
+	mVidRawIds := make(map[string]int)
+	for _, vid := range videos {
+		vThumb := vid.thumbnail
 		var vidId int
 		normalDuration := ConvertYoutubeDurationToSec(vid.duration)
 		vidTitle := ClearString(vid.title)
@@ -951,12 +1377,16 @@ func processVideos(ctx context.Context, tx *sql.Tx, videos []*vidItem, resArtist
 		if vid.commentCount == "" {
 			vid.commentCount = "0"
 		}
-		vidErr := stVideo.QueryRowContext(ctx, vid.id, vidTitle, vid.published, normalDuration, vid.likeCount, vid.viewCount, vid.commentCount, syncState, listState, vThumb).Scan(&vidId)
+		kind := videoKind(vid, shorts)
+		vidErr := stVideo.QueryRowContext(ctx, vid.id, vidTitle, vid.published, normalDuration, vid.likeCount, vid.viewCount, vid.commentCount, syncState, listState, vThumb, kind).Scan(&vidId)
 		if vidErr != nil {
 			log.Println(vidErr)
 		} else {
 			fmt.Printf("processed video: %v \n", vid.id)
 			mVidRawIds[vid.id] = vidId
+			if _, err = stStats.ExecContext(ctx, vidId, vid.viewCount, vid.likeCount, vid.commentCount); err != nil {
+				log.Println(err)
+			}
 			date, _ := time.Parse(time.DateTime, vid.published)
 			subTitle := fmt.Sprintf("%s   %s   Views: %s   Likes: %s", normalDuration, TimeAgo(date), vid.viewCount, vid.likeCount)
 			if listState == 1 {
@@ -972,6 +1402,7 @@ func processVideos(ctx context.Context, tx *sql.Tx, videos []*vidItem, resArtist
 				Thumbnail:   vThumb,
 				SyncState:   syncState,
 				ArtistIds:   []string{channelId},
+				Kind:        kind,
 			})
 		}
 	}
@@ -0,0 +1,294 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/v0vc/go-music-grpc/artist"
+)
+
+const (
+	defaultQuotaBudget = 10000
+	// с этой доли бюджета полные синки каналов откладываются, хватает ленты
+	quotaNearPercent = 90
+	quotaFlushPeriod = time.Minute
+	youtubeApiPath   = "/youtube/v3/"
+)
+
+// quotaCosts is the price of a call in units, everything else is a list call for 1 unit
+var quotaCosts = map[string]int64{
+	"search": 100,
+}
+
+var (
+	// QuotaBudget - сколько единиц в сутки позволяем себе потратить, по умолчанию вся бесплатная квота
+	QuotaBudget = int64(defaultQuotaBudget)
+	quota       = &quotaMeter{days: make(map[string]map[string]*artist.QuotaUsage)}
+)
+
+// quotaMeter counts units spent per pacific day and call type. Writing every call would take txMu as often
+// as the api is called, so the counter lives in memory and quotaFlushLoop writes it to the base.
+type quotaMeter struct {
+	mu    sync.Mutex
+	days  map[string]map[string]*artist.QuotaUsage
+	dirty bool
+}
+
+func quotaDay(now time.Time) string {
+	return now.In(pacific()).Format(time.DateOnly)
+}
+
+// spend books the call when the budget allows it
+func (m *quotaMeter) spend(method string, units int64) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	day := quotaDay(time.Now())
+	if m.usedLocked(day)+units > QuotaBudget {
+		return false
+	}
+
+	methods, ok := m.days[day]
+	if !ok {
+		methods = make(map[string]*artist.QuotaUsage)
+		m.days[day] = methods
+	}
+	use, ok := methods[method]
+	if !ok {
+		use = &artist.QuotaUsage{Day: day, Method: method}
+		methods[method] = use
+	}
+	use.Units += units
+	use.Calls++
+	m.dirty = true
+	return true
+}
+
+func (m *quotaMeter) usedLocked(day string) int64 {
+	var used int64
+	for _, use := range m.days[day] {
+		used += use.GetUnits()
+	}
+	return used
+}
+
+func (m *quotaMeter) used() int64 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.usedLocked(quotaDay(time.Now()))
+}
+
+// near is true when the rest of the budget should go to new videos only
+func (m *quotaMeter) near() bool {
+	return m.used()*100 >= QuotaBudget*quotaNearPercent
+}
+
+// snapshot copies the counters, with clear the days before today are dropped from memory
+func (m *quotaMeter) snapshot(clear bool) []*artist.QuotaUsage {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	today := quotaDay(time.Now())
+	var res []*artist.QuotaUsage
+	for day, methods := range m.days {
+		for _, use := range methods {
+			res = append(res, &artist.QuotaUsage{Day: use.GetDay(), Method: use.GetMethod(), Units: use.GetUnits(), Calls: use.GetCalls()})
+		}
+		if clear && day != today {
+			delete(m.days, day)
+		}
+	}
+	if clear {
+		m.dirty = false
+	}
+	return res
+}
+
+// quotaTransport books every call to the data api and refuses it once the daily budget is spent,
+// so a sync stops with ErrQuotaExceeded instead of a half-read channel
+type quotaTransport struct {
+	base http.RoundTripper
+}
+
+func (t *quotaTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	idx := strings.Index(req.URL.Path, youtubeApiPath)
+	if idx == -1 {
+		// лента и картинки квоту не тратят
+		return t.base.RoundTrip(req)
+	}
+
+	method := strings.Trim(req.URL.Path[idx+len(youtubeApiPath):], "/")
+	units, ok := quotaCosts[method]
+	if !ok {
+		units = 1
+	}
+	if !quota.spend(method, units) {
+		return nil, &ProviderError{
+			Kind:       ErrQuotaExceeded,
+			Provider:   providerYoutube,
+			RetryAfter: time.Until(nextPacificMidnight(time.Now())),
+			Err:        fmt.Errorf("daily budget of %v units is spent", QuotaBudget),
+		}
+	}
+	return t.base.RoundTrip(req)
+}
+
+// loadQuotaDb picks up what was spent today before the restart
+func loadQuotaDb(ctx context.Context) {
+	db, err := sql.Open(sqlite3, fmt.Sprintf("file:%v?cache=shared&mode=ro", dbFile))
+	if err != nil {
+		log.Println(err)
+	}
+	defer func(db *sql.DB) {
+		err = db.Close()
+		if err != nil {
+			log.Println(err)
+		}
+	}(db)
+
+	usage, err := getQuotaDb(ctx, db, quotaDay(time.Now()))
+	if err != nil {
+		log.Println(err)
+		return
+	}
+
+	quota.mu.Lock()
+	defer quota.mu.Unlock()
+	for _, use := range usage {
+		methods, ok := quota.days[use.GetDay()]
+		if !ok {
+			methods = make(map[string]*artist.QuotaUsage)
+			quota.days[use.GetDay()] = methods
+		}
+		methods[use.GetMethod()] = use
+	}
+}
+
+func getQuotaDb(ctx context.Context, db *sql.DB, since string) ([]*artist.QuotaUsage, error) {
+	rows, err := db.QueryContext(ctx, "select day, method, units, calls from main.youtubeQuota where day >= ? order by day desc, method;", since)
+	if err != nil {
+		return nil, err
+	}
+	defer func(rows *sql.Rows) {
+		err = rows.Close()
+		if err != nil {
+			log.Println(err)
+		}
+	}(rows)
+
+	var usage []*artist.QuotaUsage
+	for rows.Next() {
+		var use artist.QuotaUsage
+		if er := rows.Scan(&use.Day, &use.Method, &use.Units, &use.Calls); er != nil {
+			log.Println(er)
+		} else {
+			usage = append(usage, &use)
+		}
+	}
+	return usage, rows.Err()
+}
+
+// flushQuotaDb writes the counters of the meter as they are, older days are then dropped from memory
+func flushQuotaDb(ctx context.Context) {
+	quota.mu.Lock()
+	dirty := quota.dirty
+	quota.mu.Unlock()
+	if !dirty {
+		return
+	}
+
+	txMu.Lock()
+	defer txMu.Unlock()
+
+	db, err := sql.Open(sqlite3, fmt.Sprintf("file:%v?_foreign_keys=false&cache=shared&mode=rw", dbFile))
+	if err != nil {
+		log.Println(err)
+	}
+	defer func(db *sql.DB) {
+		err = db.Close()
+		if err != nil {
+			log.Println(err)
+		}
+	}(db)
+
+	tx, err := db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelSerializable})
+	if err != nil {
+		log.Println(err)
+		return
+	}
+
+	for _, use := range quota.snapshot(true) {
+		_, err = tx.ExecContext(ctx, "insert into main.youtubeQuota(day, method, units, calls) values (?,?,?,?) on conflict (day, method) do update set units = excluded.units, calls = excluded.calls;", use.GetDay(), use.GetMethod(), use.GetUnits(), use.GetCalls())
+		if err != nil {
+			log.Println(err)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.Println(err)
+	}
+}
+
+func quotaFlushLoop() {
+	ticker := time.NewTicker(quotaFlushPeriod)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		flushQuotaDb(context.Background())
+	}
+}
+
+// GetQuota returns usage of today and of the days before, the meter wins over the base for the days it holds
+func GetQuota(ctx context.Context, days int) (*artist.QuotaResponse, error) {
+	db, err := sql.Open(sqlite3, fmt.Sprintf("file:%v?cache=shared&mode=ro", dbFile))
+	if err != nil {
+		log.Println(err)
+	}
+	defer func(db *sql.DB) {
+		err = db.Close()
+		if err != nil {
+			log.Println(err)
+		}
+	}(db)
+
+	now := time.Now()
+	today := quotaDay(now)
+	usage, err := getQuotaDb(ctx, db, quotaDay(now.AddDate(0, 0, -max(days, 0))))
+	if err != nil {
+		return nil, err
+	}
+
+	mUsage := make(map[string]*artist.QuotaUsage)
+	for _, use := range usage {
+		mUsage[use.GetDay()+"/"+use.GetMethod()] = use
+	}
+	for _, use := range quota.snapshot(false) {
+		mUsage[use.GetDay()+"/"+use.GetMethod()] = use
+	}
+
+	res := &artist.QuotaResponse{
+		Day:     today,
+		Budget:  QuotaBudget,
+		ResetAt: nextPacificMidnight(now).Local().Format(time.DateTime),
+	}
+	for _, use := range mUsage {
+		res.Usage = append(res.Usage, use)
+		if use.GetDay() == today {
+			res.Used += use.GetUnits()
+		}
+	}
+	sort.Slice(res.Usage, func(i, j int) bool {
+		if res.Usage[i].GetDay() != res.Usage[j].GetDay() {
+			return res.Usage[i].GetDay() > res.Usage[j].GetDay()
+		}
+		return res.Usage[i].GetMethod() < res.Usage[j].GetMethod()
+	})
+	return res, nil
+}
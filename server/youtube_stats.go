@@ -0,0 +1,228 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/v0vc/go-music-grpc/artist"
+)
+
+const (
+	defaultStatsDays  = 7
+	defaultStatsHours = 6
+)
+
+// StatsDays - у видео моложе стольких дней счетчики еще растут, их и перечитываем
+var StatsDays = defaultStatsDays
+
+// statsKeeper is implemented by providers whose releases have view and like counters
+type statsKeeper interface {
+	RefreshStats(ctx context.Context, channelId string, days int) (int, error)
+	StatsTrend(ctx context.Context, videoId, channelId string, days int) ([]*artist.VideoStatsPoint, error)
+}
+
+func (y *youtubeProvider) RefreshStats(ctx context.Context, channelId string, days int) (int, error) {
+	return RefreshVideoStats(ctx, y.siteId, channelId, days)
+}
+
+func (y *youtubeProvider) StatsTrend(ctx context.Context, videoId, channelId string, days int) ([]*artist.VideoStatsPoint, error) {
+	return GetVideoStatsDb(ctx, y.siteId, videoId, channelId, days)
+}
+
+// RefreshVideoStats reads counters of videos newer than days again, 50 ids per api call,
+// and keeps every reading in videoStats. Returns how many videos were read.
+func RefreshVideoStats(ctx context.Context, siteId uint32, channelId string, days int) (int, error) {
+	vidIds, err := getRecentVideoIdsDb(ctx, siteId, channelId, days)
+	if err != nil || len(vidIds) == 0 {
+		return 0, err
+	}
+	fmt.Printf("siteId: %v, refresh stats of %v video(s) newer than %v days\n", siteId, len(vidIds), days)
+
+	token := GetTokenOnlyDbWoTx(ctx, siteId)
+	var total int
+	for c := range slices.Chunk(vidIds, 50) {
+		if ctx.Err() != nil {
+			return total, ctx.Err()
+		}
+		videos := GetVidByIds(ctx, strings.Join(c, ","), token)
+		if len(videos) == 0 {
+			continue
+		}
+		total += saveVideoStatsDb(ctx, videos)
+	}
+	return total, nil
+}
+
+func getRecentVideoIdsDb(ctx context.Context, siteId uint32, channelId string, days int) ([]string, error) {
+	db, err := sql.Open(sqlite3, fmt.Sprintf("file:%v?cache=shared&mode=ro", dbFile))
+	if err != nil {
+		log.Println(err)
+	}
+	defer func(db *sql.DB) {
+		err = db.Close()
+		if err != nil {
+			log.Println(err)
+		}
+	}(db)
+
+	query := "select distinct v.videoId from main.video v inner join main.playlistVideo pV on v.vid_id = pV.videoId inner join main.channelPlaylist cP on pV.playlistId = cP.playlistId inner join main.channel c on c.ch_id = cP.channelId where c.siteId = ? and v.timestamp >= datetime('now', ?)"
+	args := []interface{}{siteId, fmt.Sprintf("-%d days", days)}
+	if channelId != "" {
+		query += " and c.channelId = ?"
+		args = append(args, channelId)
+	}
+
+	rows, err := db.QueryContext(ctx, query+";", args...)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	defer func(rows *sql.Rows) {
+		err = rows.Close()
+		if err != nil {
+			log.Println(err)
+		}
+	}(rows)
+
+	var vidIds []string
+	for rows.Next() {
+		var vidId string
+		if er := rows.Scan(&vidId); er != nil {
+			log.Println(er)
+		} else {
+			vidIds = append(vidIds, vidId)
+		}
+	}
+	return vidIds, rows.Err()
+}
+
+func saveVideoStatsDb(ctx context.Context, videos []*vidItem) int {
+	txMu.Lock()
+	defer txMu.Unlock()
+
+	db, err := sql.Open(sqlite3, fmt.Sprintf("file:%v?_foreign_keys=true&cache=shared&mode=rw", dbFile))
+	if err != nil {
+		log.Println(err)
+	}
+	defer func(db *sql.DB) {
+		err = db.Close()
+		if err != nil {
+			log.Println(err)
+		}
+	}(db)
+
+	tx, err := db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelSerializable})
+	if err != nil {
+		log.Println(err)
+		return 0
+	}
+
+	var saved int
+	for _, vid := range videos {
+		likeCount, viewCount, commentCount := counterOrZero(vid.likeCount), counterOrZero(vid.viewCount), counterOrZero(vid.commentCount)
+		_, err = tx.ExecContext(ctx, "update main.video set likeCount = ?, viewCount = ?, commentCount = ? where videoId = ?;", likeCount, viewCount, commentCount, vid.id)
+		if err != nil {
+			log.Println(err)
+			continue
+		}
+		_, err = tx.ExecContext(ctx, "insert into main.videoStats(videoId, viewCount, likeCount, commentCount) select vid_id, ?, ?, ? from main.video where videoId = ?;", viewCount, likeCount, commentCount, vid.id)
+		if err != nil {
+			log.Println(err)
+			continue
+		}
+		saved++
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.Println(err)
+		return 0
+	}
+	return saved
+}
+
+func counterOrZero(count string) string {
+	if count == "" {
+		// ютуб не отдает скрытые счетчики
+		return "0"
+	}
+	return count
+}
+
+// GetVideoStatsDb returns readings of the video, or of the channel summed by day over the last reading of each video
+func GetVideoStatsDb(ctx context.Context, siteId uint32, videoId, channelId string, days int) ([]*artist.VideoStatsPoint, error) {
+	db, err := sql.Open(sqlite3, fmt.Sprintf("file:%v?cache=shared&mode=ro", dbFile))
+	if err != nil {
+		log.Println(err)
+	}
+	defer func(db *sql.DB) {
+		err = db.Close()
+		if err != nil {
+			log.Println(err)
+		}
+	}(db)
+
+	var (
+		query string
+		args  []interface{}
+		since string
+	)
+	if days > 0 {
+		since = " and s.taken >= datetime('now', ?)"
+	}
+	if videoId != "" {
+		query = "select s.taken, s.viewCount, s.likeCount, s.commentCount, 1 from main.videoStats s join main.video v on v.vid_id = s.videoId where v.videoId = ?" + since + " order by s.taken;"
+		args = append(args, videoId)
+	} else {
+		query = "select d.day, sum(d.viewCount), sum(d.likeCount), sum(d.commentCount), count(d.videoId) from (select date(s.taken) as day, s.videoId, max(s.viewCount) as viewCount, max(s.likeCount) as likeCount, max(s.commentCount) as commentCount from main.videoStats s where s.videoId in (select pV.videoId from main.playlistVideo pV join main.channelPlaylist cP on cP.playlistId = pV.playlistId join main.channel c on c.ch_id = cP.channelId where c.channelId = ? and c.siteId = ?)" + since + " group by 1, 2) d group by d.day order by d.day;"
+		args = append(args, channelId, siteId)
+	}
+	if days > 0 {
+		args = append(args, fmt.Sprintf("-%d days", days))
+	}
+
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	defer func(rows *sql.Rows) {
+		err = rows.Close()
+		if err != nil {
+			log.Println(err)
+		}
+	}(rows)
+
+	var points []*artist.VideoStatsPoint
+	for rows.Next() {
+		var point artist.VideoStatsPoint
+		if er := rows.Scan(&point.Taken, &point.ViewCount, &point.LikeCount, &point.CommentCount, &point.VideoCount); er != nil {
+			log.Println(er)
+		} else {
+			points = append(points, &point)
+		}
+	}
+	return points, rows.Err()
+}
+
+// refreshStatsLoop refreshes counters of fresh videos every interval until the server stops
+func refreshStatsLoop(interval time.Duration) {
+	if interval <= 0 {
+		return
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		total, err := RefreshVideoStats(context.Background(), siteYoutube, "", StatsDays)
+		if err != nil {
+			log.Printf("Refresh stats error: %v", err)
+		} else {
+			fmt.Printf("siteId: %v, stats refreshed, videos: %v\n", siteYoutube, total)
+		}
+	}
+}
@@ -58,7 +58,11 @@ type Statistics struct {
 		ContentDetails struct {
 			Duration string `json:"duration,omitempty"`
 		} `json:"contentDetails,omitempty"`
-		Statistics struct {
+		Snippet struct {
+			LiveBroadcastContent string `json:"liveBroadcastContent,omitempty"`
+		} `json:"snippet,omitempty"`
+		LiveStreamingDetails *LiveStreamingDetails `json:"liveStreamingDetails,omitempty"`
+		Statistics           struct {
 			ViewCount    string `json:"viewCount,omitempty"`
 			LikeCount    string `json:"likeCount,omitempty"`
 			CommentCount string `json:"commentCount,omitempty"`
@@ -93,9 +97,10 @@ type VideoById struct {
 	Items []struct {
 		ID      string `json:"id,omitempty"`
 		Snippet struct {
-			PublishedAt string `json:"publishedAt,omitempty"`
-			Title       string `json:"title,omitempty"`
-			Thumbnails  struct {
+			PublishedAt          string `json:"publishedAt,omitempty"`
+			Title                string `json:"title,omitempty"`
+			LiveBroadcastContent string `json:"liveBroadcastContent,omitempty"`
+			Thumbnails           struct {
 				Default struct {
 					URL string `json:"url,omitempty"`
 				} `json:"default,omitempty"`
@@ -104,7 +109,8 @@ type VideoById struct {
 		ContentDetails struct {
 			Duration string `json:"duration,omitempty"`
 		} `json:"contentDetails,omitempty"`
-		Statistics struct {
+		LiveStreamingDetails *LiveStreamingDetails `json:"liveStreamingDetails,omitempty"`
+		Statistics           struct {
 			ViewCount    string `json:"viewCount,omitempty"`
 			LikeCount    string `json:"likeCount,omitempty"`
 			CommentCount string `json:"commentCount,omitempty"`
@@ -127,6 +133,19 @@ type PlaylistByChannel struct {
 	} `json:"items,omitempty"`
 }
 
+// ChannelFeed is the public atom feed of the channel, the last 15 uploads without quota
+type ChannelFeed struct {
+	Entries []struct {
+		VideoID string `xml:"videoId"`
+	} `xml:"entry"`
+}
+
+// LiveStreamingDetails is only sent for streams and premieres, past or upcoming
+type LiveStreamingDetails struct {
+	ActualStartTime    string `json:"actualStartTime,omitempty"`
+	ScheduledStartTime string `json:"scheduledStartTime,omitempty"`
+}
+
 type vidItem struct {
 	id            string
 	title         string
@@ -136,6 +155,12 @@ type vidItem struct {
 	viewCount     string
 	commentCount  string
 	thumbnailLink string
+	// превью качаем до записи, вместе с остальным из апи
+	thumbnail []byte
+	broadcast string
+	isLive    bool
+	// actualStartTime из liveStreamingDetails, пусто пока эфир не начался
+	started string
 }
 
 type plItem struct {
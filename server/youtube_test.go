@@ -0,0 +1,30 @@
+package main
+
+import "testing"
+
+func TestVideoKind(t *testing.T) {
+	shorts := map[string]bool{"short2min": true}
+	tests := []struct {
+		name string
+		vid  vidItem
+		want int32
+	}{
+		{"regular", vidItem{id: "regular", duration: "PT10M3S"}, videoKindRegular},
+		{"short by duration", vidItem{id: "short", duration: "PT45S"}, videoKindShort},
+		{"short from the playlist", vidItem{id: "short2min", duration: "PT2M"}, videoKindShort},
+		{"long video is not a short", vidItem{id: "long2min", duration: "PT2M"}, videoKindRegular},
+		{"upcoming stream", vidItem{id: "stream", duration: "P0D", broadcast: "upcoming", isLive: true}, videoKindLive},
+		{"stream on air", vidItem{id: "stream", duration: "P0D", broadcast: "live", isLive: true, published: "2026-05-01 18:00:02", started: "2026-05-01T18:00:00Z"}, videoKindLive},
+		{"stream record", vidItem{id: "stream", duration: "PT1H2M", broadcast: "none", isLive: true, published: "2026-05-01 18:00:02", started: "2026-05-01T18:00:00Z"}, videoKindLive},
+		{"upcoming premiere", vidItem{id: "premiere", duration: "PT4M", broadcast: "upcoming", isLive: true, published: "2026-05-01 12:00:00"}, videoKindPremiere},
+		{"aired premiere", vidItem{id: "premiere", duration: "PT4M", broadcast: "none", isLive: true, published: "2026-05-01 12:00:00", started: "2026-05-01T18:00:01.5Z"}, videoKindPremiere},
+		{"bad start time", vidItem{id: "stream", duration: "PT1H", broadcast: "none", isLive: true, published: "2026-05-01 12:00:00", started: "yesterday"}, videoKindLive},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := videoKind(&tt.vid, shorts); got != tt.want {
+				t.Errorf("videoKind = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
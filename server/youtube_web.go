@@ -3,6 +3,7 @@ package main
 import (
 	"context"
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 	"io"
 	"log"
@@ -10,25 +11,41 @@ import (
 	"os"
 	"slices"
 	"strings"
+	"time"
 
 	"github.com/lrstanley/go-ytdlp"
+	"github.com/v0vc/go-music-grpc/artist"
 )
 
 const (
 	youtubeVideo        = "https://www.youtube.com/watch?v="
 	youtubePlaylist     = "https://www.youtube.com/playlist?list="
 	youtubeApi          = "https://www.googleapis.com/youtube/v3/"
+	youtubeFeed         = "https://www.youtube.com/feeds/videos.xml?channel_id="
 	chanelString        = "channels?id=[ID]&key=[KEY]&part=contentDetails,snippet,statistics&fields=items(contentDetails(relatedPlaylists(uploads)),snippet(title,thumbnails(default(url))),statistics(viewCount,subscriberCount))&prettyPrint=false"
 	uploadString        = "playlistItems?key=[KEY]&playlistId=[ID]&part=snippet,contentDetails&order=date&fields=nextPageToken,items(snippet(publishedAt,title,resourceId(videoId),thumbnails(default(url))),contentDetails(videoPublishedAt))&maxResults=50&prettyPrint=false"
 	playlistIdsString   = "playlistItems?key=[KEY]&playlistId=[ID]&part=snippet&fields=nextPageToken,items(snippet(resourceId(videoId)))&maxResults=50&prettyPrint=false"
-	statisticString     = "videos?id=[VID]&key=[KEY]&part=contentDetails,statistics&fields=items(id,contentDetails(duration),statistics(viewCount,commentCount,likeCount))&prettyPrint=false"
+	statisticString     = "videos?id=[VID]&key=[KEY]&part=snippet,contentDetails,statistics,liveStreamingDetails&fields=items(id,contentDetails(duration),snippet(liveBroadcastContent),liveStreamingDetails(actualStartTime,scheduledStartTime),statistics(viewCount,commentCount,likeCount))&prettyPrint=false"
 	channelIdByVideoId  = "videos?id=[ID]&key=[KEY]&part=snippet&fields=items(snippet(channelId))&prettyPrint=false"
 	channelIdByVideoIds = "videos?id=[ID]&key=[KEY]&part=snippet&fields=items(id,snippet(channelId))&prettyPrint=false"
 	channelIdByHandle   = "channels?forHandle=[ID]&key=[KEY]&part=snippet&fields=items(id)&{PrintType}&prettyPrint=false"
-	vidByIdsString      = "videos?id=[VID]&key=[KEY]&part=snippet,statistics,contentDetails&fields=items(id,contentDetails(duration),snippet(publishedAt,title,thumbnails(default(url))),statistics(viewCount,commentCount,likeCount))&prettyPrint=false"
+	vidByIdsString      = "videos?id=[VID]&key=[KEY]&part=snippet,statistics,contentDetails,liveStreamingDetails&fields=items(id,contentDetails(duration),snippet(publishedAt,title,liveBroadcastContent,thumbnails(default(url))),liveStreamingDetails(actualStartTime,scheduledStartTime),statistics(viewCount,commentCount,likeCount))&prettyPrint=false"
+	pingChannelId       = "UC_x5XG1OV2P6uZZ5FSM9Ttw"
 	playlistByChannelId = "playlists?channelId=[ID]&key=[KEY]&part=snippet&fields=nextPageToken,items(id,snippet(title,thumbnails(default(url))))&maxResults=50&prettyPrint=false"
 )
 
+// youtubeError closes the body of a failed api response and returns the typed error for it
+func youtubeError(response *http.Response) error {
+	defer func(Body io.ReadCloser) {
+		err := Body.Close()
+		if err != nil {
+			log.Println(err)
+		}
+	}(response.Body)
+
+	return httpError(providerYoutube, response)
+}
+
 func GetChannelId(ctx context.Context, token string, id string) (string, error) {
 	var url string
 	if strings.HasPrefix(id, "@") {
@@ -41,10 +58,13 @@ func GetChannelId(ctx context.Context, token string, id string) (string, error)
 	if err != nil {
 		return "", err
 	}
-	response, err := http.DefaultClient.Do(req)
-	if err != nil || response == nil || response.StatusCode != http.StatusOK {
+	response, err := youtubeClient.Do(req)
+	if err != nil || response == nil {
 		return "", err
 	}
+	if response.StatusCode != http.StatusOK {
+		return "", youtubeError(response)
+	}
 
 	defer func(Body io.ReadCloser) {
 		err = Body.Close()
@@ -59,6 +79,9 @@ func GetChannelId(ctx context.Context, token string, id string) (string, error)
 		if err != nil || chId == nil {
 			return "", err
 		}
+		if len(chId.Items) == 0 {
+			return "", newProviderError(providerYoutube, ErrNotFound, fmt.Errorf("no channel for: %s", id))
+		}
 		return chId.Items[0].ID, nil
 	} else {
 		var chId *ChannelId
@@ -66,6 +89,9 @@ func GetChannelId(ctx context.Context, token string, id string) (string, error)
 		if err != nil || chId == nil {
 			return "", err
 		}
+		if len(chId.Items) == 0 {
+			return "", newProviderError(providerYoutube, ErrNotFound, fmt.Errorf("no channel for: %s", id))
+		}
 		return chId.Items[0].Snippet.ChannelID, nil
 	}
 }
@@ -76,10 +102,13 @@ func GetChannelIdsByVid(ctx context.Context, token string, ids string, channelId
 	if err != nil {
 		return "", err
 	}
-	response, err := http.DefaultClient.Do(req)
-	if err != nil || response == nil || response.StatusCode != http.StatusOK {
+	response, err := youtubeClient.Do(req)
+	if err != nil || response == nil {
 		return "", err
 	}
+	if response.StatusCode != http.StatusOK {
+		return "", youtubeError(response)
+	}
 
 	defer func(Body io.ReadCloser) {
 		err = Body.Close()
@@ -117,10 +146,13 @@ func GetChannel(ctx context.Context, channelId string, apiKey string) (*Channel,
 	if err != nil {
 		return new(Channel), err
 	}
-	response, err := http.DefaultClient.Do(req)
-	if err != nil || response == nil || response.StatusCode != http.StatusOK {
+	response, err := youtubeClient.Do(req)
+	if err != nil || response == nil {
 		return new(Channel), err
 	}
+	if response.StatusCode != http.StatusOK {
+		return new(Channel), youtubeError(response)
+	}
 
 	defer func(Body io.ReadCloser) {
 		err = Body.Close()
@@ -137,14 +169,29 @@ func GetChannel(ctx context.Context, channelId string, apiKey string) (*Channel,
 	return channel, nil
 }
 
-func GetUploadVid(ctx context.Context, uploadId string, token string) []*vidItem {
+// pingYoutube asks for one well known channel, it costs a single unit of the daily quota
+func pingYoutube(ctx context.Context, apiKey string) error {
+	ch, err := GetChannel(ctx, pingChannelId, apiKey)
+	if err != nil {
+		return err
+	}
+	if len(ch.Items) == 0 {
+		return newProviderError(providerYoutube, ErrApiChanged, fmt.Errorf("no channel for: %s", pingChannelId))
+	}
+	return nil
+}
+
+func GetUploadVid(ctx context.Context, uploadId string, token string) ([]*vidItem, error) {
 	var videos []*vidItem
 	urlRaw := strings.Replace(strings.Replace(uploadString, "[ID]", uploadId, 1), "[KEY]", token, 1)
 	url := urlRaw
 	i := 0
 	for {
 		upl, e := geUpload(ctx, url)
-		if e == nil && upl != nil {
+		if e != nil {
+			return videos, e
+		}
+		if upl != nil {
 			var sb strings.Builder
 			for _, vid := range upl.Items {
 				sb.WriteString(vid.Snippet.ResourceID.VideoID + ",")
@@ -165,6 +212,11 @@ func GetUploadVid(ctx context.Context, uploadId string, token string) []*vidItem
 						videos[idx].likeCount = vid.Statistics.LikeCount
 						videos[idx].viewCount = vid.Statistics.ViewCount
 						videos[idx].commentCount = vid.Statistics.CommentCount
+						videos[idx].broadcast = vid.Snippet.LiveBroadcastContent
+						videos[idx].isLive = vid.LiveStreamingDetails != nil
+						if vid.LiveStreamingDetails != nil {
+							videos[idx].started = vid.LiveStreamingDetails.ActualStartTime
+						}
 					} else {
 						log.Println("Failed to find video ", vid.ID)
 					}
@@ -180,17 +232,20 @@ func GetUploadVid(ctx context.Context, uploadId string, token string) []*vidItem
 		}
 		i++
 	}
-	return videos
+	return videos, nil
 }
 
-func GetPlaylistVidIds(ctx context.Context, uploadId string, token string) []string {
+func GetPlaylistVidIds(ctx context.Context, uploadId string, token string) ([]string, error) {
 	var netIds []string
 	urlRaw := strings.Replace(strings.Replace(playlistIdsString, "[ID]", uploadId, 1), "[KEY]", token, 1)
 	url := urlRaw
 	i := 0
 	for {
 		upl, e := geUploadIds(ctx, url)
-		if e == nil && upl != nil {
+		if e != nil {
+			return netIds, e
+		}
+		if upl != nil {
 			for _, vid := range upl.Items {
 				netIds = append(netIds, vid.Snippet.ResourceID.VideoID)
 			}
@@ -204,7 +259,43 @@ func GetPlaylistVidIds(ctx context.Context, uploadId string, token string) []str
 		}
 		i++
 	}
-	return netIds
+	return netIds, nil
+}
+
+// GetFeedVidIds returns ids of the last uploads from the channel feed, newest first
+func GetFeedVidIds(ctx context.Context, channelId string) ([]string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, youtubeFeed+channelId, nil)
+	if err != nil {
+		return nil, err
+	}
+	response, err := youtubeClient.Do(req)
+	if err != nil || response == nil {
+		return nil, err
+	}
+
+	defer func(Body io.ReadCloser) {
+		err = Body.Close()
+		if err != nil {
+			log.Println(err)
+		}
+	}(response.Body)
+
+	if response.StatusCode != http.StatusOK {
+		return nil, httpError(providerYoutube, response)
+	}
+
+	var feed ChannelFeed
+	if err = xml.NewDecoder(response.Body).Decode(&feed); err != nil {
+		return nil, err
+	}
+
+	var ids []string
+	for _, entry := range feed.Entries {
+		if entry.VideoID != "" {
+			ids = append(ids, entry.VideoID)
+		}
+	}
+	return ids, nil
 }
 
 func GetVidByIds(ctx context.Context, vidIds string, token string) []*vidItem {
@@ -222,7 +313,12 @@ func GetVidByIds(ctx context.Context, vidIds string, token string) []*vidItem {
 				viewCount:     vi.Statistics.ViewCount,
 				commentCount:  vi.Statistics.CommentCount,
 				thumbnailLink: vi.Snippet.Thumbnails.Default.URL,
+				broadcast:     vi.Snippet.LiveBroadcastContent,
+				isLive:        vi.LiveStreamingDetails != nil,
 			})
+			if vi.LiveStreamingDetails != nil {
+				videos[len(videos)-1].started = vi.LiveStreamingDetails.ActualStartTime
+			}
 		}
 	}
 	return videos
@@ -261,7 +357,7 @@ func GetPlaylists(ctx context.Context, channelId string, token string) []*plItem
 	return res
 }
 
-func DownloadVideo(ctx context.Context, videoPath, id, quality string, isPl bool) (string, error) {
+func DownloadVideo(ctx context.Context, videoPath, id, quality string, isPl bool, progress DownloadProgress) (string, error) {
 	install, err := ytdlp.Install(ctx, &ytdlp.InstallOptions{AllowVersionMismatch: false})
 	if err != nil {
 		return "-1", err
@@ -280,6 +376,34 @@ func DownloadVideo(ctx context.Context, videoPath, id, quality string, isPl bool
 		SponsorblockRemove("all").
 		Output(videoPath + string(os.PathSeparator) + "%(title)s.%(ext)s")
 
+	if progress != nil {
+		dl.ProgressFunc(progressInterval*time.Millisecond, func(update ytdlp.ProgressUpdate) {
+			event := &artist.DownloadEvent{
+				EventType:  downloadEventProgress,
+				TrackId:    id,
+				Bytes:      int64(update.DownloadedBytes),
+				TotalBytes: int64(update.TotalBytes),
+				Quality:    quality,
+			}
+			if update.Info != nil {
+				if update.Info.Title != nil {
+					event.Title = *update.Info.Title
+				}
+				if update.Info.Format != "" {
+					event.Quality = update.Info.Format
+				}
+			}
+			if sec := update.Duration().Seconds(); sec > 0 {
+				event.Speed = int64(float64(update.DownloadedBytes) / sec)
+			}
+			if update.Status == ytdlp.ProgressStatusError {
+				event.EventType = downloadEventFailed
+				event.Error = "yt-dlp reported an error"
+			}
+			progress.emit(event)
+		})
+	}
+
 	var link string
 	if isPl {
 		link = youtubePlaylist + id
@@ -301,10 +425,13 @@ func geUpload(ctx context.Context, url string) (*Uploads, error) {
 	if err != nil {
 		return new(Uploads), err
 	}
-	response, err := http.DefaultClient.Do(req)
-	if err != nil || response == nil || response.StatusCode != http.StatusOK {
+	response, err := youtubeClient.Do(req)
+	if err != nil || response == nil {
 		return new(Uploads), err
 	}
+	if response.StatusCode != http.StatusOK {
+		return new(Uploads), youtubeError(response)
+	}
 
 	defer func(Body io.ReadCloser) {
 		err = Body.Close()
@@ -326,10 +453,13 @@ func geUploadIds(ctx context.Context, url string) (*UploadIds, error) {
 	if err != nil {
 		return new(UploadIds), err
 	}
-	response, err := http.DefaultClient.Do(req)
-	if err != nil || response == nil || response.StatusCode != http.StatusOK {
+	response, err := youtubeClient.Do(req)
+	if err != nil || response == nil {
 		return new(UploadIds), err
 	}
+	if response.StatusCode != http.StatusOK {
+		return new(UploadIds), youtubeError(response)
+	}
 
 	defer func(Body io.ReadCloser) {
 		err = Body.Close()
@@ -351,10 +481,13 @@ func geStatistics(ctx context.Context, url string) (*Statistics, error) {
 	if err != nil {
 		return new(Statistics), err
 	}
-	response, err := http.DefaultClient.Do(req)
-	if err != nil || response == nil || response.StatusCode != http.StatusOK {
+	response, err := youtubeClient.Do(req)
+	if err != nil || response == nil {
 		return new(Statistics), err
 	}
+	if response.StatusCode != http.StatusOK {
+		return new(Statistics), youtubeError(response)
+	}
 
 	defer func(Body io.ReadCloser) {
 		err = Body.Close()
@@ -376,10 +509,13 @@ func getVidById(ctx context.Context, url string) (*VideoById, error) {
 	if err != nil {
 		return new(VideoById), err
 	}
-	response, err := http.DefaultClient.Do(req)
-	if err != nil || response == nil || response.StatusCode != http.StatusOK {
+	response, err := youtubeClient.Do(req)
+	if err != nil || response == nil {
 		return new(VideoById), err
 	}
+	if response.StatusCode != http.StatusOK {
+		return new(VideoById), youtubeError(response)
+	}
 
 	defer func(Body io.ReadCloser) {
 		err = Body.Close()
@@ -401,10 +537,13 @@ func getPlaylist(ctx context.Context, url string) (*PlaylistByChannel, error) {
 	if err != nil {
 		return new(PlaylistByChannel), err
 	}
-	response, err := http.DefaultClient.Do(req)
-	if err != nil || response == nil || response.StatusCode != http.StatusOK {
+	response, err := youtubeClient.Do(req)
+	if err != nil || response == nil {
 		return new(PlaylistByChannel), err
 	}
+	if response.StatusCode != http.StatusOK {
+		return new(PlaylistByChannel), youtubeError(response)
+	}
 
 	defer func(Body io.ReadCloser) {
 		err = Body.Close()
@@ -1,8 +1,10 @@
 package main
 
 import (
+	"cmp"
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"log"
@@ -172,6 +174,90 @@ func getExistIdsDb(tx *sql.Tx, ctx context.Context, artId int, siteId uint32) ([
 	return existAlbumIds, existArtistIds
 }
 
+// needFullSyncDb is true when the artist was never fully synced or the last full sync is older than FullSyncDays,
+// then the whole discography is read again to catch back-catalog additions
+func needFullSyncDb(tx *sql.Tx, ctx context.Context, artId int) bool {
+	var need bool
+
+	err := tx.QueryRowContext(ctx, "select lastFullSync is null or lastFullSync < datetime('now', ?) from main.artist where art_id = ?;", fmt.Sprintf("-%d days", FullSyncDays), artId).Scan(&need)
+	if err != nil {
+		log.Println(err)
+		return true
+	}
+
+	return need
+}
+
+func setFullSyncDb(tx *sql.Tx, ctx context.Context, artId int) {
+	_, err := tx.ExecContext(ctx, "update main.artist set lastFullSync = datetime('now') where art_id = ?;", artId)
+	if err != nil {
+		log.Println(err)
+	}
+}
+
+// syncReleasesDb marks releases seen in the artist's own discography as listed, clears the mark of the
+// returned ones and, after a complete walk, stamps listed releases missing on the site as removed.
+// Ids of newly removed releases are returned.
+func syncReleasesDb(tx *sql.Tx, ctx context.Context, artId int, netAlbumIds []string, complete bool) []string {
+	if len(netAlbumIds) > 0 {
+		inIds := strings.TrimSuffix(strings.Repeat("?,", len(netAlbumIds)), ",")
+		args := []interface{}{artId}
+		for _, id := range netAlbumIds {
+			args = append(args, id)
+		}
+		execs := []string{
+			"update main.artistAlbum set listed = 1 where artistId = ? and listed = 0 and albumId in (select alb_id from main.album where albumId in (" + inIds + "));",
+			"update main.album set removedAt = null where removedAt is not null and alb_id in (select albumId from main.artistAlbum where artistId = ?) and albumId in (" + inIds + ");",
+		}
+		for _, exec := range execs {
+			if _, err := tx.ExecContext(ctx, exec, args...); err != nil {
+				log.Println(err)
+			}
+		}
+	}
+
+	if !complete {
+		// по неполному списку нельзя понять, что пропало
+		return nil
+	}
+
+	var removed []string
+	query := "select a.albumId from main.album a join main.artistAlbum aa on aa.albumId = a.alb_id where aa.artistId = ? and aa.listed = 1 and a.removedAt is null"
+	args := []interface{}{artId}
+	if len(netAlbumIds) > 0 {
+		query += " and a.albumId not in (" + strings.TrimSuffix(strings.Repeat("?,", len(netAlbumIds)), ",") + ")"
+		for _, id := range netAlbumIds {
+			args = append(args, id)
+		}
+	}
+	rows, err := tx.QueryContext(ctx, query+";", args...)
+	if err != nil {
+		log.Println(err)
+		return nil
+	}
+	for rows.Next() {
+		var albumId string
+		if err = rows.Scan(&albumId); err != nil {
+			log.Println(err)
+			continue
+		}
+		removed = append(removed, albumId)
+	}
+	_ = rows.Close()
+
+	if len(removed) > 0 {
+		args = []interface{}{}
+		for _, id := range removed {
+			args = append(args, id)
+		}
+		_, err = tx.ExecContext(ctx, "update main.album set removedAt = datetime('now') where albumId in ("+strings.TrimSuffix(strings.Repeat("?,", len(removed)), ",")+");", args...)
+		if err != nil {
+			log.Println(err)
+		}
+	}
+	return removed
+}
+
 func deleteBase(ctx context.Context, tx *sql.Tx, artistId string, siteId uint32) (int64, error) {
 	var (
 		aff int64
@@ -219,33 +305,36 @@ func deleteBase(ctx context.Context, tx *sql.Tx, artistId string, siteId uint32)
 	return aff, tx.Commit()
 }
 
-func DownloadAlbum(ctx context.Context, siteId uint32, albIds []string, trackQuality string) (map[string]string, error) {
+func DownloadAlbum(ctx context.Context, siteId uint32, albIds []string, trackQuality string, progress DownloadProgress) (map[string]string, error) {
+	if progress != nil {
+		report := progress
+		progress = func(event *artist.DownloadEvent) {
+			event.SiteId = siteId
+			report(event)
+		}
+	}
 	token := GetTokenOnlyDbWoTx(ctx, siteId)
 	mDownloaded := make(map[string]string)
 	mTracks := make(map[string]*AlbumInfo)
 
 	for _, albumId := range albIds {
-		var tryCount int
-	L1:
-		item, err, canContinue := getAlbumTracks(ctx, albumId, token)
-		if err != nil && !canContinue {
-			return mDownloaded, err
-		}
-		if item == nil && canContinue {
-			tryCount += 1
-			if tryCount == 4 {
+		// повторы на 418 и 5xx уже сделал zvukClient
+		item, err := getAlbumTracks(ctx, albumId, token)
+		var pe *ProviderError
+		if errors.As(err, &pe) {
+			if pe.Kind == ErrNotFound || pe.Kind == ErrUnavailable || pe.Kind == ErrThrottled {
+				progress.emit(&artist.DownloadEvent{EventType: downloadEventFailed, AlbumId: albumId, Error: err.Error()})
 				continue
 			}
-
-			RandomPause(3, 7)
-
-			goto L1
+			return mDownloaded, err
 		}
 		if item == nil {
 			log.Println("Can't get release info from api, skipped..")
+			progress.emit(&artist.DownloadEvent{EventType: downloadEventFailed, AlbumId: albumId, Error: "can't get release info from api"})
 			continue
 		}
 		if len(item.Result.Tracks) > 0 {
+			SaveTracksDb(ctx, item)
 			for trId, track := range item.Result.Tracks {
 				if trId != "" {
 					_, ok := mTracks[trId]
@@ -294,13 +383,207 @@ func DownloadAlbum(ctx context.Context, siteId uint32, albIds []string, trackQua
 	}
 
 	for trackId, albInfo := range mTracks {
-		downloadFiles(ctx, trackId, token, trackQuality, albInfo, mDownloaded)
+		progress.emit(newTrackEvent(downloadEventQueued, trackId, albInfo))
+	}
+
+	for trackId, albInfo := range mTracks {
+		downloadFiles(ctx, trackId, token, trackQuality, albInfo, mDownloaded, progress)
+		if ctx.Err() != nil {
+			// отменили посреди альбома, остальные треки не качаем
+			return mDownloaded, ctx.Err()
+		}
 		RandomPause(3, 7)
 	}
 	return mDownloaded, nil
 }
 
-func GetNewReleasesFromDb(ctx context.Context, siteId uint32) ([]*artist.Album, error) {
+// ReadAlbumTracks returns the tracklist of the release, the api is asked only when nothing is stored yet
+func ReadAlbumTracks(ctx context.Context, siteId uint32, albumId string) ([]*artist.Track, error) {
+	tracks, err := GetAlbumTracksFromDb(ctx, albumId)
+	if err != nil || len(tracks) > 0 {
+		return tracks, err
+	}
+
+	item, err := getAlbumTracks(ctx, albumId, GetTokenOnlyDbWoTx(ctx, siteId))
+	if err != nil {
+		return nil, err
+	}
+	if item == nil || len(item.Result.Tracks) == 0 {
+		return nil, newProviderError(providerZvuk, ErrNotFound, fmt.Errorf("no tracks for release: %s", albumId))
+	}
+	SaveTracksDb(ctx, item)
+	return releaseTracks(item, albumId), nil
+}
+
+// releaseTracks picks the tracks of the release from the api response in the order GetAlbumTracksFromDb
+// reads them back, so the first read of a release doesn't differ from the next ones
+func releaseTracks(item *ReleaseInfo, albumId string) []*artist.Track {
+	var tracks []*artist.Track
+	for _, track := range item.Result.Tracks {
+		if track.ID != 0 && strconv.Itoa(track.ReleaseID) == albumId {
+			tracks = append(tracks, mapTrack(&track))
+		}
+	}
+	slices2.SortFunc(tracks, func(a, b *artist.Track) int {
+		if c := cmp.Compare(a.GetPosition(), b.GetPosition()); c != 0 {
+			return c
+		}
+		x, _ := strconv.Atoi(a.GetTrackId())
+		y, _ := strconv.Atoi(b.GetTrackId())
+		return cmp.Compare(x, y)
+	})
+	return tracks
+}
+
+func mapTrack(track *Track) *artist.Track {
+	return &artist.Track{
+		TrackId:        strconv.Itoa(track.ID),
+		Position:       int32(track.Position),
+		Title:          track.Title,
+		Duration:       int32(track.Duration),
+		Genres:         track.Genres,
+		HasFlac:        track.HasFlac,
+		HighestQuality: track.HighestQuality,
+		Explicit:       track.Explicit,
+		ArtistNames:    track.ArtistNames,
+	}
+}
+
+// SaveTracksDb stores the tracks of the api response and the track count of their releases,
+// releases that are not in the base are skipped
+func SaveTracksDb(ctx context.Context, item *ReleaseInfo) {
+	txMu.Lock()
+	defer txMu.Unlock()
+
+	db, err := sql.Open(sqlite3, fmt.Sprintf("file:%v?_foreign_keys=true&cache=shared&mode=rw", dbFile))
+	if err != nil {
+		log.Println(err)
+	}
+	defer func(db *sql.DB) {
+		err = db.Close()
+		if err != nil {
+			log.Println(err)
+		}
+	}(db)
+
+	tx, err := db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelSerializable})
+	if err != nil {
+		log.Println(err)
+		return
+	}
+
+	stTrack, err := tx.PrepareContext(ctx, "insert into main.track(albumId, trackId, position, title, duration, genres, hasFlac, highestQuality, explicit, artistNames) select alb_id, ?, ?, ?, ?, ?, ?, ?, ?, ? from main.album where albumId = ? limit 1 on conflict (albumId, trackId) do update set position = excluded.position, title = excluded.title, duration = excluded.duration, genres = excluded.genres, hasFlac = excluded.hasFlac, highestQuality = excluded.highestQuality, explicit = excluded.explicit, artistNames = excluded.artistNames;")
+	if err != nil {
+		log.Println(err)
+		_ = tx.Rollback()
+		return
+	}
+	defer func(stTrack *sql.Stmt) {
+		err = stTrack.Close()
+		if err != nil {
+			log.Println(err)
+		}
+	}(stTrack)
+
+	mTotal := make(map[string]int)
+	for _, track := range item.Result.Tracks {
+		if track.ID == 0 {
+			continue
+		}
+		albumId := strconv.Itoa(track.ReleaseID)
+		mTotal[albumId]++
+		_, err = stTrack.ExecContext(ctx, strconv.Itoa(track.ID), track.Position, track.Title, track.Duration, marshalStrings(track.Genres), track.HasFlac, track.HighestQuality, track.Explicit, marshalStrings(track.ArtistNames), albumId)
+		if err != nil {
+			log.Println(err)
+		}
+	}
+
+	for albumId, total := range mTotal {
+		if release, ok := item.Result.Releases[albumId]; ok && len(release.TrackIds) > total {
+			// в ответе не все треки, альбом неполный
+			total = len(release.TrackIds)
+		}
+		_, err = tx.ExecContext(ctx, "update main.album set trackTotal = ? where albumId = ?;", total, albumId)
+		if err != nil {
+			log.Println(err)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.Println(err)
+	}
+}
+
+func GetAlbumTracksFromDb(ctx context.Context, albumId string) ([]*artist.Track, error) {
+	db, err := sql.Open(sqlite3, fmt.Sprintf("file:%v?cache=shared&mode=ro", dbFile))
+	if err != nil {
+		log.Println(err)
+	}
+	defer func(db *sql.DB) {
+		err = db.Close()
+		if err != nil {
+			log.Println(err)
+		}
+	}(db)
+
+	rows, err := db.QueryContext(ctx, "select t.trackId, t.position, ifnull(t.title, ''), t.duration, ifnull(t.genres, ''), t.hasFlac, ifnull(t.highestQuality, ''), t.explicit, ifnull(t.artistNames, '') from main.track t join main.album a on a.alb_id = t.albumId where a.albumId = ? order by t.position, cast(t.trackId as integer);", albumId)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	defer func(rows *sql.Rows) {
+		err = rows.Close()
+		if err != nil {
+			log.Println(err)
+		}
+	}(rows)
+
+	var tracks []*artist.Track
+
+	for rows.Next() {
+		var (
+			track               artist.Track
+			genres, artistNames string
+		)
+		if err = rows.Scan(&track.TrackId, &track.Position, &track.Title, &track.Duration, &genres, &track.HasFlac, &track.HighestQuality, &track.Explicit, &artistNames); err != nil {
+			log.Println(err)
+			continue
+		}
+		track.Genres = unmarshalStrings(genres)
+		track.ArtistNames = unmarshalStrings(artistNames)
+		tracks = append(tracks, &track)
+	}
+	return tracks, rows.Err()
+}
+
+// marshalStrings keeps a list as json, a join would split "Earth, Wind & Fire" on the way back
+func marshalStrings(values []string) string {
+	if len(values) == 0 {
+		return ""
+	}
+	res, err := json.Marshal(values)
+	if err != nil {
+		log.Println(err)
+	}
+	return string(res)
+}
+
+func unmarshalStrings(raw string) []string {
+	if raw == "" {
+		return nil
+	}
+	if !strings.HasPrefix(raw, "[") {
+		// так писали до json, запятые внутри имен там уже потеряны
+		return strings.Split(raw, ", ")
+	}
+	var values []string
+	if err := json.Unmarshal([]byte(raw), &values); err != nil {
+		log.Println(err)
+	}
+	return values
+}
+
+func GetNewReleasesFromDb(ctx context.Context, siteId uint32, f *albumFilter) ([]*artist.Album, string, error) {
 	db, err := sql.Open(sqlite3, fmt.Sprintf("file:%v?cache=shared&mode=ro", dbFile))
 	if err != nil {
 		log.Println(err)
@@ -312,7 +595,9 @@ func GetNewReleasesFromDb(ctx context.Context, siteId uint32) ([]*artist.Album,
 		}
 	}(db)
 
-	stRows, err := db.PrepareContext(ctx, "select a.alb_id, a.title, a.albumId, a.releaseDate, a.releaseType, group_concat(ar.title, ', ') as subTitle, group_concat(ar.artistId, ',') as artIds, a.thumbnail from main.artistAlbum aa join main.album a on a.alb_id = aa.albumId join main.artist ar on ar.art_id = aa.artistId where a.syncState = 1 and ar.siteId = ? group by aa.albumId order by 4 desc;")
+	cond, args := f.where("a.releaseDate", "a.releaseType")
+	cond += f.whereRemoved("a.removedAt")
+	stRows, err := db.PrepareContext(ctx, "select a.alb_id, a.title, a.albumId, a.releaseDate, a.releaseType, group_concat(ar.title, ', ') as subTitle, group_concat(ar.artistId, ',') as artIds, a.thumbnail, ifnull(a.removedAt, '') from main.artistAlbum aa join main.album a on a.alb_id = aa.albumId join main.artist ar on ar.art_id = aa.artistId where a.syncState = 1 and ar.siteId = ?"+cond+" group by aa.albumId"+f.orderBy("4 desc", "1", sortColumns[siteZvuk])+f.limit()+";")
 	if err != nil {
 		log.Println(err)
 	}
@@ -323,7 +608,7 @@ func GetNewReleasesFromDb(ctx context.Context, siteId uint32) ([]*artist.Album,
 		}
 	}(stRows)
 
-	rows, err := stRows.QueryContext(ctx, siteId)
+	rows, err := stRows.QueryContext(ctx, append([]interface{}{siteId}, args...)...)
 	if err != nil {
 		log.Println(err)
 	}
@@ -334,14 +619,22 @@ func GetNewReleasesFromDb(ctx context.Context, siteId uint32) ([]*artist.Album,
 		}
 	}(rows)
 
-	var albs []*artist.Album
+	var (
+		albs    []*artist.Album
+		scanned int
+	)
 
 	for rows.Next() {
+		scanned++
+		if f.pastPage(scanned) {
+			// лишняя строка только говорит, что есть следующая страница
+			break
+		}
 		var alb artist.Album
 
 		var artIds string
 
-		if err = rows.Scan(&alb.Id, &alb.Title, &alb.AlbumId, &alb.ReleaseDate, &alb.ReleaseType, &alb.SubTitle, &artIds, &alb.Thumbnail); err != nil {
+		if err = rows.Scan(&alb.Id, &alb.Title, &alb.AlbumId, &alb.ReleaseDate, &alb.ReleaseType, &alb.SubTitle, &artIds, &alb.Thumbnail, &alb.RemovedAt); err != nil {
 			log.Println(err)
 		} else {
 			alb.ArtistIds = append(alb.ArtistIds, strings.Split(artIds, ",")...)
@@ -349,10 +642,11 @@ func GetNewReleasesFromDb(ctx context.Context, siteId uint32) ([]*artist.Album,
 		}
 	}
 
-	return albs, err
+	albs, nextToken := page(f, albs, scanned)
+	return albs, nextToken, err
 }
 
-func GetArtistReleasesFromDb(ctx context.Context, siteId uint32, artistId string) ([]*artist.Album, error) {
+func GetArtistReleasesFromDb(ctx context.Context, siteId uint32, artistId string, f *albumFilter) ([]*artist.Album, string, error) {
 	db, err := sql.Open(sqlite3, fmt.Sprintf("file:%v?cache=shared&mode=ro", dbFile))
 	if err != nil {
 		log.Println(err)
@@ -364,7 +658,9 @@ func GetArtistReleasesFromDb(ctx context.Context, siteId uint32, artistId string
 		}
 	}(db)
 
-	stRows, err := db.PrepareContext(ctx, "select a.alb_id, a.title, a.albumId, a.releaseDate, a.releaseType, group_concat(ar.title, ', ') as subTitle, group_concat(ar.artistId, ',') as artIds, a.thumbnail, a.syncState from main.artistAlbum aa join main.album a on a.alb_id = aa.albumId join main.artist ar on ar.art_id = aa.artistId where a.alb_id in (select ab.albumId from main.artistAlbum ab where ab.artistId = (select art.art_id from main.artist art where art.artistId = ? limit 1)) and ar.siteId = ? group by aa.albumId order by 9 desc, 4 desc;")
+	cond, args := f.where("a.releaseDate", "a.releaseType")
+	cond += f.whereRemoved("a.removedAt")
+	stRows, err := db.PrepareContext(ctx, "select a.alb_id, a.title, a.albumId, a.releaseDate, a.releaseType, group_concat(ar.title, ', ') as subTitle, group_concat(ar.artistId, ',') as artIds, a.thumbnail, a.syncState, ifnull(a.removedAt, '') from main.artistAlbum aa join main.album a on a.alb_id = aa.albumId join main.artist ar on ar.art_id = aa.artistId where a.alb_id in (select ab.albumId from main.artistAlbum ab where ab.artistId = (select art.art_id from main.artist art where art.artistId = ? limit 1)) and ar.siteId = ?"+cond+" group by aa.albumId"+f.orderBy("9 desc, 4 desc", "1", sortColumns[siteZvuk])+f.limit()+";")
 	if err != nil {
 		log.Println(err)
 	}
@@ -375,7 +671,7 @@ func GetArtistReleasesFromDb(ctx context.Context, siteId uint32, artistId string
 		}
 	}(stRows)
 
-	rows, err := stRows.QueryContext(ctx, artistId, siteId)
+	rows, err := stRows.QueryContext(ctx, append([]interface{}{artistId, siteId}, args...)...)
 	if err != nil {
 		log.Println(err)
 	}
@@ -386,15 +682,23 @@ func GetArtistReleasesFromDb(ctx context.Context, siteId uint32, artistId string
 		}
 	}(rows)
 
-	var albs []*artist.Album
+	var (
+		albs    []*artist.Album
+		scanned int
+	)
 
 	for rows.Next() {
+		scanned++
+		if f.pastPage(scanned) {
+			// лишняя строка только говорит, что есть следующая страница
+			break
+		}
 		var (
 			alb    artist.Album
 			artIds string
 		)
 
-		if err = rows.Scan(&alb.Id, &alb.Title, &alb.AlbumId, &alb.ReleaseDate, &alb.ReleaseType, &alb.SubTitle, &artIds, &alb.Thumbnail, &alb.SyncState); err != nil {
+		if err = rows.Scan(&alb.Id, &alb.Title, &alb.AlbumId, &alb.ReleaseDate, &alb.ReleaseType, &alb.SubTitle, &artIds, &alb.Thumbnail, &alb.SyncState, &alb.RemovedAt); err != nil {
 			log.Println(err)
 		} else {
 			alb.ArtistIds = append(alb.ArtistIds, strings.Split(artIds, ",")...)
@@ -402,7 +706,8 @@ func GetArtistReleasesFromDb(ctx context.Context, siteId uint32, artistId string
 		}
 	}
 
-	return albs, err
+	albs, nextToken := page(f, albs, scanned)
+	return albs, nextToken, err
 }
 
 func DeleteArtistsDb(ctx context.Context, siteId uint32, artistId []string, isUserAdd bool) (int64, error) {
@@ -541,7 +846,8 @@ func GetArtistIdsFromDb(ctx context.Context, siteId uint32) ([]ArtistRawId, erro
 
 	var artistIds []ArtistRawId
 
-	stmtArt, err := db.PrepareContext(ctx, "select a.art_id, a.artistId from main.artist a where a.siteId = ? and a.userAdded = 1;")
+	// берем только тех, кому пора, любимых первыми
+	stmtArt, err := db.PrepareContext(ctx, "select a.art_id, a.artistId from main.artist a where a.siteId = ? and a.userAdded = 1"+syncDue("a")+syncOrder("a")+";")
 	if err != nil {
 		log.Println(err)
 	}
@@ -552,7 +858,7 @@ func GetArtistIdsFromDb(ctx context.Context, siteId uint32) ([]ArtistRawId, erro
 		}
 	}(stmtArt)
 
-	rows, err := stmtArt.QueryContext(ctx, siteId)
+	rows, err := stmtArt.QueryContext(ctx, siteId, SyncHours)
 	if err != nil {
 		log.Println(err)
 	}
@@ -582,7 +888,7 @@ func GetArtists(ctx context.Context, siteId uint32) ([]*artist.Artist, error) {
 
 	var arts []*artist.Artist
 
-	stmt, err := db.PrepareContext(ctx, "select ar.art_id, ar.artistId, ar.title, ar.thumbnail, count(al.alb_id) as news from main.artist ar join main.artistAlbum aa on ar.art_id = aa.artistId left outer join main.album al on aa.albumId = al.alb_id and al.syncState = 1 where ar.userAdded = 1 and ar.siteId = ? group by ar.art_id order by 3;")
+	stmt, err := db.PrepareContext(ctx, "select ar.art_id, ar.artistId, ar.title, ar.thumbnail, count(al.alb_id) as news, ar.skipSync, ar.syncInterval, ar.priority, ifnull(ar.lastSync,'') from main.artist ar join main.artistAlbum aa on ar.art_id = aa.artistId left outer join main.album al on aa.albumId = al.alb_id and al.syncState = 1 where ar.userAdded = 1 and ar.siteId = ? group by ar.art_id order by 3;")
 	if err != nil {
 		log.Println(err)
 	}
@@ -605,8 +911,8 @@ func GetArtists(ctx context.Context, siteId uint32) ([]*artist.Artist, error) {
 	}(rows)
 
 	for rows.Next() {
-		var art artist.Artist
-		if e := rows.Scan(&art.Id, &art.ArtistId, &art.Title, &art.Thumbnail, &art.NewAlbs); e != nil {
+		art := artist.Artist{Sync: &artist.SyncSettings{}}
+		if e := rows.Scan(&art.Id, &art.ArtistId, &art.Title, &art.Thumbnail, &art.NewAlbs, &art.Sync.Paused, &art.Sync.IntervalHours, &art.Sync.Priority, &art.Sync.LastSync); e != nil {
 			log.Println(e)
 		} else {
 			art.SiteId = siteId
@@ -655,8 +961,9 @@ func ClearAlbSyncStateDb(ctx context.Context, siteId uint32) (int64, error) {
 	return aff, tx.Commit()
 }
 
-func SyncArtist(ctx context.Context, siteId uint32, artistId ArtistRawId, isAdd bool) (*artist.Artist, []string, error) {
-	db, err := sql.Open(sqlite3, fmt.Sprintf("file:%v?_foreign_keys=true&cache=shared&mode=rw", dbFile))
+// AckAlbSyncStateDb сбрасывает признак новизны только у части релизов и возвращает, сколько новых осталось
+func AckAlbSyncStateDb(ctx context.Context, siteId uint32, ack *acknowledge) (int64, int64, error) {
+	db, err := sql.Open(sqlite3, fmt.Sprintf("file:%v?_foreign_keys=false&cache=shared&mode=rw", dbFile))
 	if err != nil {
 		log.Println(err)
 	}
@@ -672,15 +979,56 @@ func SyncArtist(ctx context.Context, siteId uint32, artistId ArtistRawId, isAdd
 		log.Println(err)
 	}
 
-	token := GetTokenOnlyDb(tx, ctx, siteId)
-	item, err := getArtistReleases(ctx, artistId.Id, token)
-	if item == nil || err != nil {
+	cond, args := ack.where("a.albumId", "ar.artistId", "a.releaseDate")
+	stRows, err := tx.PrepareContext(ctx, "update main.album set syncState = 0 where album.alb_id in (select a.alb_id from main.album a inner join main.artistAlbum aA on a.alb_id = aA.albumId inner join main.artist ar on ar.art_id = aA.artistId where ar.siteId = ? and a.syncState = 1"+cond+" group by a.alb_id);")
+	if err != nil {
+		log.Println(err)
+		return 0, 0, rollbackWith(tx, err)
+	}
+	defer func(stRows *sql.Stmt) {
+		err = stRows.Close()
+		if err != nil {
+			log.Println(err)
+		}
+	}(stRows)
+
+	rows, err := stRows.ExecContext(ctx, append([]interface{}{siteId}, args...)...)
+	if err != nil {
+		log.Println(err)
+		return 0, 0, rollbackWith(tx, err)
+	}
+	aff, err := rows.RowsAffected()
+	if err != nil {
+		log.Println(err)
+	}
+
+	var remaining int64
+	err = tx.QueryRowContext(ctx, "select count(distinct a.alb_id) from main.album a inner join main.artistAlbum aA on a.alb_id = aA.albumId inner join main.artist ar on ar.art_id = aA.artistId where ar.siteId = ? and a.syncState = 1;", siteId).Scan(&remaining)
+	if err != nil {
+		log.Println(err)
+		return 0, 0, rollbackWith(tx, err)
+	}
+
+	return aff, remaining, tx.Commit()
+}
+
+func SyncArtist(ctx context.Context, siteId uint32, artistId ArtistRawId, isAdd bool) (*artist.Artist, []*artist.Artist, []string, error) {
+	db, err := sql.Open(sqlite3, fmt.Sprintf("file:%v?_foreign_keys=true&cache=shared&mode=rw", dbFile))
+	if err != nil {
+		log.Println(err)
+	}
+	defer func(db *sql.DB) {
+		err = db.Close()
+		if err != nil {
+			log.Println(err)
+		}
+	}(db)
+
+	txMu.Lock()
+	tx, err := db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelSerializable})
+	if err != nil {
 		log.Println(err)
-		return nil, []string{}, tx.Rollback()
 	}
-	/*if needTokenUpd {
-		UpdateTokenDb(tx, ctx, token, siteId)
-	}*/
 
 	var (
 		artRawId, userAdded int
@@ -707,6 +1055,27 @@ func SyncArtist(ctx context.Context, siteId uint32, artistId ArtistRawId, isAdd
 		mArtist[artistId.Id] = artRawId
 	}
 
+	var known map[string]bool
+	if !isAdd && len(existAlbumIds) > 0 && !needFullSyncDb(tx, ctx, artRawId) {
+		known = make(map[string]bool, len(existAlbumIds))
+		for _, id := range existAlbumIds {
+			known[id] = true
+		}
+	}
+
+	token := GetTokenOnlyDb(tx, ctx, siteId)
+	// в апи ходим без транзакции, чтобы другие синки успели записать свое
+	if err = tx.Rollback(); err != nil {
+		log.Println(err)
+	}
+	txMu.Unlock()
+
+	item, complete, err := getArtistReleases(ctx, artistId.Id, token, known)
+	if item == nil || err != nil {
+		log.Println(err)
+		return nil, nil, []string{}, withArtist(err, artistId.Id)
+	}
+
 	for _, data := range item.GetArtists {
 		for _, release := range data.Discography.All.Releases {
 			if release.ID == "" {
@@ -727,7 +1096,11 @@ func SyncArtist(ctx context.Context, siteId uint32, artistId ArtistRawId, isAdd
 		}
 	}
 
-	deletedArtistIds := FindDifference(existArtistIds, netArtistIds)
+	var deletedArtistIds []string
+	if complete {
+		// по неполному списку нельзя понять, кто пропал
+		deletedArtistIds = FindDifference(existArtistIds, netArtistIds)
+	}
 	fmt.Printf("siteId: %v, artistId: %d, deleted: %d\n", siteId, artRawId, len(deletedArtistIds))
 
 	newAlbumIds := FindDifference(netAlbumIds, existAlbumIds)
@@ -743,24 +1116,54 @@ func SyncArtist(ctx context.Context, siteId uint32, artistId ArtistRawId, isAdd
 		processedAlbumIds  []string
 		albThumbDb         map[string][]byte
 	)
-	if isAdd {
-		albThumbDb, err = getAlbumThumbsDb(tx, ctx, netAlbumIds)
+	if isAdd && len(netAlbumIds) > 0 {
+		err = readTx(ctx, db, func(tx *sql.Tx) {
+			var er error
+			if albThumbDb, er = getAlbumThumbsDb(tx, ctx, netAlbumIds); er != nil {
+				log.Println(er)
+			}
+		})
 		if err != nil {
 			log.Println(err)
 		}
 	}
 
+	// превью качаем до txMu, как и канал youtube: под ним остается только запись
+	if thumb == nil {
+		thumb = GetThumb(ctx, strings.Replace(item.GetArtists[0].Image.Src, "{size}", thumbSize, 1))
+	}
+	albThumbNet := make(map[string][]byte)
+	for _, data := range item.GetArtists {
+		for _, release := range data.Discography.All.Releases {
+			if release.ID == "" {
+				continue
+			}
+			if _, ok := albThumbNet[release.ID]; ok {
+				continue
+			}
+			if _, ok := albThumbDb[release.ID]; ok && !slices2.Contains(newAlbumIds, release.ID) {
+				continue
+			}
+			if isAdd || slices2.Contains(newAlbumIds, release.ID) {
+				albThumbNet[release.ID] = GetThumb(ctx, strings.Replace(release.Image.Src, "{size}", thumbSize, 1))
+			}
+		}
+	}
+
+	txMu.Lock()
+	defer txMu.Unlock()
+	tx, err = db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelSerializable})
+	if err != nil {
+		log.Println(err)
+		return nil, nil, []string{}, err
+	}
+
 	resArtist := &artist.Artist{
 		SiteId:    siteId,
 		ArtistId:  item.GetArtists[0].ID,
 		Title:     item.GetArtists[0].Title,
 		UserAdded: true,
-	}
-	if thumb == nil {
-		thumb = GetThumb(ctx, strings.Replace(item.GetArtists[0].Image.Src, "{size}", thumbSize, 1))
-		resArtist.Thumbnail = thumb
-	} else {
-		resArtist.Thumbnail = thumb
+		Thumbnail: thumb,
 	}
 	artists = append(artists, resArtist)
 
@@ -776,7 +1179,7 @@ func SyncArtist(ctx context.Context, siteId uint32, artistId ArtistRawId, isAdd
 			}
 			if slices2.Contains(newAlbumIds, release.ID) && !slices2.Contains(processedAlbumIds, release.ID) {
 				alb.AlbumId = release.ID
-				alb.Thumbnail = GetThumb(ctx, strings.Replace(release.Image.Src, "{size}", thumbSize, 1))
+				alb.Thumbnail = albThumbNet[release.ID]
 				if !isAdd {
 					alb.SyncState = 1
 				}
@@ -787,7 +1190,7 @@ func SyncArtist(ctx context.Context, siteId uint32, artistId ArtistRawId, isAdd
 					if ok {
 						alb.Thumbnail = th
 					} else {
-						alb.Thumbnail = GetThumb(ctx, strings.Replace(release.Image.Src, "{size}", thumbSize, 1))
+						alb.Thumbnail = albThumbNet[release.ID]
 					}
 				}
 			}
@@ -993,5 +1396,19 @@ func SyncArtist(ctx context.Context, siteId uint32, artistId ArtistRawId, isAdd
 		}
 	}
 
-	return resArtist, deletedArtistIds, tx.Commit()
+	if ownId, ok := mArtist[artistId.Id]; ok && ownId != 0 {
+		resArtist.DeletedAlb = syncReleasesDb(tx, ctx, ownId, netAlbumIds, complete)
+		if len(resArtist.DeletedAlb) > 0 {
+			fmt.Printf("siteId: %v, artistId: %d, removed albums: %d\n", siteId, ownId, len(resArtist.DeletedAlb))
+		}
+	}
+
+	if complete && artRawId != 0 {
+		setFullSyncDb(tx, ctx, artRawId)
+	}
+	if ownId := mArtist[artistId.Id]; ownId != 0 {
+		setLastSyncDb(tx, ctx, siteId, ownId)
+	}
+
+	return resArtist, artists[1:], deletedArtistIds, tx.Commit()
 }
@@ -177,6 +177,8 @@ type WriteCounter struct {
 	Downloaded int64
 	Percentage int
 	StartTime  int64
+	OnProgress func(downloaded, total, speed int64)
+	lastReport int64
 }
 
 func (wc *WriteCounter) Write(p []byte) (int, error) {
@@ -192,5 +194,13 @@ func (wc *WriteCounter) Write(p []byte) (int, error) {
 
 	fmt.Printf("\r%d%% @ %s/s, %s/%s ", wc.Percentage, humanize.Bytes(uint64(speed)),
 		humanize.Bytes(uint64(wc.Downloaded)), wc.TotalStr)
+
+	if wc.OnProgress != nil {
+		now := time.Now().UnixMilli()
+		if now-wc.lastReport >= progressInterval || wc.Downloaded == wc.Total {
+			wc.lastReport = now
+			wc.OnProgress(wc.Downloaded, wc.Total, speed)
+		}
+	}
 	return n, nil
 }
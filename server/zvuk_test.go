@@ -0,0 +1,48 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+)
+
+func TestAlbumTracksRoundTrip(t *testing.T) {
+	newTestDb(t)
+	execAll(t, "insert into main.album(albumId, title) values ('300', 'September');")
+
+	item := &ReleaseInfo{}
+	item.Result.Tracks = map[string]Track{
+		"12": {ID: 12, ReleaseID: 300, Position: 2, Title: "Boogie Wonderland", Genres: []string{"funk", "disco"}, ArtistNames: []string{"Earth, Wind & Fire", "The Emotions"}},
+		"11": {ID: 11, ReleaseID: 300, Position: 1, Title: "September", HasFlac: true, ArtistNames: []string{"Earth, Wind & Fire"}},
+		"10": {ID: 10, ReleaseID: 300, Position: 1, Title: "September (Reprise)"},
+		"20": {ID: 20, ReleaseID: 301, Position: 1, Title: "Other release"},
+	}
+	SaveTracksDb(context.Background(), item)
+
+	fromApi := releaseTracks(item, "300")
+	fromDb, err := GetAlbumTracksFromDb(context.Background(), "300")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(fromApi) != 3 || len(fromDb) != len(fromApi) {
+		t.Fatalf("got %v tracks from the api and %v from the db, want 3", len(fromApi), len(fromDb))
+	}
+	for i := range fromApi {
+		if !proto.Equal(fromApi[i], fromDb[i]) {
+			t.Errorf("track %v: api %v, db %v", i, fromApi[i], fromDb[i])
+		}
+	}
+	if names := fromDb[2].GetArtistNames(); len(names) != 2 || names[0] != "Earth, Wind & Fire" {
+		t.Errorf("artist names = %q", names)
+	}
+}
+
+func TestUnmarshalStringsLegacy(t *testing.T) {
+	if got := unmarshalStrings("funk, disco"); len(got) != 2 || got[1] != "disco" {
+		t.Errorf("unmarshalStrings = %q", got)
+	}
+	if got := unmarshalStrings(""); got != nil {
+		t.Errorf("unmarshalStrings(\"\") = %q", got)
+	}
+}
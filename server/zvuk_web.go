@@ -3,6 +3,7 @@ package main
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"log"
@@ -13,11 +14,14 @@ import (
 	"path/filepath"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/dustin/go-humanize"
 
+	"github.com/v0vc/go-music-grpc/artist"
 	"github.com/v0vc/graphql"
+	"golang.org/x/sync/singleflight"
 )
 
 const (
@@ -29,6 +33,7 @@ const (
 	trackTemplatePlaylist = "{{.artist}} - {{.title}}"
 	albumTemplate         = "{{.year}} - {{.album}}"
 	releaseChunk          = 100
+	releaseChunkInc       = 20
 	authHeader            = "x-auth-token"
 	uaHeader              = "user-agent"
 	thumbSize             = "64x64"
@@ -36,7 +41,8 @@ const (
 	ua                    = "Mozilla/5.0 (Macintosh; Intel Mac OS X 14.3; rv:115.0) Gecko/20100101 Firefox/115.0"
 )
 
-type Transport struct{ auth string }
+// Transport adds the browser headers to every request to zvuk
+type Transport struct{ base http.RoundTripper }
 
 var (
 	jar, _          = cookiejar.New(nil)
@@ -48,14 +54,161 @@ var (
 )
 
 func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
-	req.Header.Add(uaHeader, ua)
-	if t.auth != "" {
-		req.Header.Add("cookie", fmt.Sprintf("auth=%s", t.auth))
+	if req.Header.Get(uaHeader) == "" {
+		// повтор приходит с уже выставленными заголовками
+		req.Header.Add(uaHeader, ua)
 	}
-	return http.DefaultTransport.RoundTrip(req)
+	return t.base.RoundTrip(req)
 }
 
-/*func getTokenFromSite(ctx context.Context, email, password string) (string, error) {
+func setAuthCookie(req *http.Request, token string) {
+	req.Header.Add("cookie", fmt.Sprintf("auth=%s", token))
+}
+
+// authTransport renews the zvuk token with the login and pass from the site table when the api answers 401,
+// then replays the request once with the new token
+type authTransport struct{ base http.RoundTripper }
+
+type noRenewKey struct{}
+
+var (
+	// renewGroup склеивает вход всех запросов, получивших 401 с одним токеном
+	renewGroup singleflight.Group
+	renewMu    sync.Mutex
+	// renewed последняя замена протухшего токена, старые цепочки найдут новый токен в базе
+	renewed struct{ old, fresh string }
+)
+
+// withoutRenew is for checks of a given token, there 401 is the answer and not a reason to log in
+func withoutRenew(ctx context.Context) context.Context {
+	return context.WithValue(ctx, noRenewKey{}, true)
+}
+
+func requestToken(req *http.Request) string {
+	if token := req.Header.Get(authHeader); token != "" {
+		return token
+	}
+	if cookie, err := req.Cookie("auth"); err == nil {
+		return cookie.Value
+	}
+	return ""
+}
+
+// withToken copies the request with the token replaced in the header and the cookie
+func withToken(req *http.Request, old, token string) (*http.Request, error) {
+	r := req.Clone(req.Context())
+	if req.Body != nil {
+		if req.GetBody == nil {
+			return nil, fmt.Errorf("can't replay %v %v", req.Method, req.URL.Path)
+		}
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		r.Body = body
+	}
+
+	if r.Header.Get(authHeader) != "" {
+		r.Header.Set(authHeader, token)
+	}
+	for i, value := range r.Header.Values("cookie") {
+		r.Header["Cookie"][i] = strings.ReplaceAll(value, "auth="+old, "auth="+token)
+	}
+	return r, nil
+}
+
+func (t *authTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	token := requestToken(req)
+	if token != "" {
+		if fresh, ok := renewedToken(token); ok {
+			r, err := withToken(req, token, fresh)
+			if err != nil {
+				return nil, err
+			}
+			req, token = r, fresh
+		}
+	}
+
+	response, err := t.base.RoundTrip(req)
+	if err != nil || response.StatusCode != http.StatusUnauthorized || token == "" || req.Context().Value(noRenewKey{}) != nil {
+		return response, err
+	}
+
+	fresh, err := renewZvukToken(req.Context(), token)
+	if err != nil {
+		_ = response.Body.Close()
+		return nil, newProviderError(providerZvuk, ErrUnauthenticated, fmt.Errorf("token renewal failed: %w", err))
+	}
+
+	r, err := withToken(req, token, fresh)
+	if err != nil {
+		// повторить нечем, вернем 401 как есть
+		return response, nil
+	}
+	_ = response.Body.Close()
+
+	response, err = t.base.RoundTrip(r)
+	if err == nil && response.StatusCode == http.StatusUnauthorized {
+		log.Println("zvuk rejected the renewed token too, check login and pass")
+	}
+	return response, err
+}
+
+func renewedToken(old string) (string, bool) {
+	renewMu.Lock()
+	defer renewMu.Unlock()
+	return renewed.fresh, renewed.old == old && renewed.fresh != ""
+}
+
+func setRenewedToken(old, fresh string) {
+	renewMu.Lock()
+	defer renewMu.Unlock()
+	renewed.old, renewed.fresh = old, fresh
+}
+
+// renewZvukToken logs in once for all requests that got 401 with the same token, nothing is locked
+// while the login and the write of the token run
+func renewZvukToken(ctx context.Context, old string) (string, error) {
+	// вход общий для всех ждущих, отмена одного запроса не должна его прерывать
+	ctx = context.WithoutCancel(ctx)
+	fresh, err, _ := renewGroup.Do(old, func() (interface{}, error) {
+		if fresh, ok := renewedToken(old); ok {
+			return fresh, nil
+		}
+		// токен могли уже поменять руками через UpdateSiteCredentials
+		if current := GetTokenOnlyDbWoTx(ctx, siteZvuk); current != "" && current != old {
+			setRenewedToken(old, current)
+			return current, nil
+		}
+
+		login, pass, err := GetCredentialsDb(ctx, siteZvuk)
+		if err != nil {
+			return "", err
+		}
+		if login == "" || pass == "" {
+			return "", errors.New("no login or pass for the site")
+		}
+
+		fmt.Printf("siteId: %v, token expired, login as %v\n", siteZvuk, login)
+		fresh, err := getTokenFromSite(ctx, login, pass)
+		if err != nil {
+			return "", err
+		}
+		if err = UpdateTokenDb(ctx, siteZvuk, fresh); err != nil {
+			return "", err
+		}
+		setRenewedToken(old, fresh)
+		fmt.Printf("siteId: %v, token renewed\n", siteZvuk)
+
+		return fresh, nil
+	})
+	if err != nil {
+		return "", err
+	}
+	return fresh.(string), nil
+}
+
+func getTokenFromSite(ctx context.Context, email, password string) (string, error) {
 	data := url.Values{}
 	data.Set("email", email)
 	data.Set("password", password)
@@ -65,9 +218,7 @@ func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
 	}
 
 	req.Header.Add("Content-Type", "application/x-www-form-urlencoded")
-	client := &http.Client{Jar: jar, Transport: &Transport{}}
-	defer client.CloseIdleConnections()
-	do, err := client.Do(req)
+	do, err := zvukLoginClient.Do(req)
 	if err != nil || do == nil {
 		return "", err
 	}
@@ -79,34 +230,36 @@ func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
 		}
 	}(do.Body)
 	if do.StatusCode != http.StatusOK {
-		return "", err
+		return "", httpError(providerZvuk, do)
 	}
 
 	var obj *Auth
 	err = json.NewDecoder(do.Body).Decode(&obj)
-	if err != nil || obj == nil {
+	if err != nil {
 		return "", err
 	}
+	if obj == nil || obj.Result.Token == "" {
+		return "", errors.New("no token in the login response")
+	}
 	return obj.Result.Token, nil
-}*/
+}
 
-func getAlbumTracks(ctx context.Context, albumId, token string) (*ReleaseInfo, error, bool) {
+func getAlbumTracks(ctx context.Context, albumId, token string) (*ReleaseInfo, error) {
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, apiBase+apiRelease, nil)
 	if err != nil {
 		log.Println(err)
-		return nil, err, false
+		return nil, err
 	}
 
 	query := url.Values{}
 	query.Set("ids", albumId)
 	query.Set("include", "track")
 	req.URL.RawQuery = query.Encode()
-	client := &http.Client{Jar: jar, Transport: &Transport{auth: token}}
-	defer client.CloseIdleConnections()
-	do, err := client.Do(req)
+	setAuthCookie(req, token)
+	do, err := zvukClient.Do(req)
 	if err != nil || do == nil {
 		log.Println(err)
-		return nil, err, false
+		return nil, err
 	}
 
 	defer func(Body io.ReadCloser) {
@@ -118,24 +271,25 @@ func getAlbumTracks(ctx context.Context, albumId, token string) (*ReleaseInfo, e
 
 	switch do.StatusCode {
 	case http.StatusTeapot:
-		log.Println("Got status: Teapot, too many requests, throttling started..")
-		return nil, nil, true
+		log.Println("Got status: Teapot, too many requests, release skipped..")
+		return nil, httpError(providerZvuk, do)
 	case http.StatusUnauthorized:
 		log.Println("Try to renew access token...")
-		return nil, nil, false
+		return nil, httpError(providerZvuk, do)
 	case http.StatusForbidden:
 		log.Println("Something was changed in api, please report. Exit...")
-		return nil, nil, false
+		return nil, httpError(providerZvuk, do)
 	case http.StatusOK:
 		var obj ReleaseInfo
 
 		err = json.NewDecoder(do.Body).Decode(&obj)
 		if err != nil {
 			log.Println("Can't decode response from api: ", err)
+			return nil, err
 		}
-		return &obj, err, true
+		return &obj, nil
 	default:
-		return nil, err, false
+		return nil, httpError(providerZvuk, do)
 	}
 }
 
@@ -150,17 +304,37 @@ func setGraphqlHeaders(req *graphql.Request, token string) {
 	req.Header.Add("accept", "*/*")
 }
 
-func getArtistReleases(ctx context.Context, artistId, token string) (*ArtistAlbums, error) {
+// pingZvuk is the cheapest graphql call with the token, enough to see if the api accepts it
+func pingZvuk(ctx context.Context, token string) error {
+	graphqlRequest := graphql.NewRequest(`query { __typename }`)
+	setGraphqlHeaders(graphqlRequest, token)
+
+	graphqlClient := graphql.NewClient(apiBase+"api/v1/graphql", graphql.WithHTTPClient(zvukClient))
+
+	var graphqlResponse interface{}
+	return graphqlError(graphqlClient.Run(ctx, graphqlRequest, &graphqlResponse))
+}
+
+// getArtistReleases pages through discography.all, newest first. With known ids set it stops at the first page
+// without new releases and reports that the list is not complete, so nothing can be treated as deleted.
+func getArtistReleases(ctx context.Context, artistId, token string, known map[string]bool) (*ArtistAlbums, bool, error) {
 	var (
 		res         ArtistAlbums
 		hasNextPage = true
+		complete    = true
 		cursor      string
 		err         error
+		chunk       = releaseChunk
 	)
 
+	if known != nil {
+		// новое всегда в начале, большие страницы не нужны
+		chunk = releaseChunkInc
+	}
+
 	for hasNextPage {
 		graphqlRequest := graphql.NewRequest(`query artistReleases($ids: [ID!]!, $limit: Int = 100, $cursor: String = null) { getArtists(ids: $ids) { id title image { src } discography { all(limit: $limit, cursor: $cursor) { releases { id title type image { src } artists { id title } date } page_info { endCursor hasNextPage } } } } }`)
-		graphqlRequest.Var("limit", releaseChunk)
+		graphqlRequest.Var("limit", chunk)
 		if cursor != "" {
 			graphqlRequest.Var("cursor", cursor)
 		} else {
@@ -169,48 +343,68 @@ func getArtistReleases(ctx context.Context, artistId, token string) (*ArtistAlbu
 		graphqlRequest.Var("ids", []string{artistId})
 		setGraphqlHeaders(graphqlRequest, token)
 
-		graphqlClient := graphql.NewClient(apiBase+"api/v1/graphql", graphql.WithHTTPClient(&http.Client{Jar: jar, Transport: &Transport{}}))
+		graphqlClient := graphql.NewClient(apiBase+"api/v1/graphql", graphql.WithHTTPClient(zvukClient))
 
 		var graphqlResponse interface{}
 		err = graphqlClient.Run(ctx, graphqlRequest, &graphqlResponse)
 		if err != nil {
-			return nil, err
+			return nil, false, graphqlError(err)
 		}
 
 		jsonString, er := json.Marshal(graphqlResponse)
 		if er != nil {
-			return nil, er
+			return nil, false, er
 		}
 
-		var obj ArtistAlbums
+		var (
+			obj       ArtistAlbums
+			pageStart int
+		)
 		if res.GetArtists == nil {
 			err = json.Unmarshal(jsonString, &res)
 			if err != nil {
-				return nil, err
+				return nil, false, err
 			}
 			if len(res.GetArtists) == 1 {
 				hasNextPage = res.GetArtists[0].Discography.All.PageInfo.HasNextPage
 				cursor = res.GetArtists[0].Discography.All.PageInfo.EndCursor
 			} else {
-				return nil, fmt.Errorf("bad api response for artist: %s", artistId)
+				return nil, false, newProviderError(providerZvuk, ErrNotFound, fmt.Errorf("bad api response for artist: %s", artistId))
 			}
 
 		} else {
 			err = json.Unmarshal(jsonString, &obj)
 			if err != nil {
-				return nil, err
+				return nil, false, err
 			}
 			if len(res.GetArtists) == 1 {
+				pageStart = len(res.GetArtists[0].Discography.All.Releases)
 				res.GetArtists[0].Discography.All.Releases = append(res.GetArtists[0].Discography.All.Releases, obj.GetArtists[0].Discography.All.Releases...)
 				hasNextPage = obj.GetArtists[0].Discography.All.PageInfo.HasNextPage
 				cursor = obj.GetArtists[0].Discography.All.PageInfo.EndCursor
 			} else {
-				return nil, fmt.Errorf("bad api response for artist: %s", artistId)
+				return nil, false, newProviderError(providerZvuk, ErrNotFound, fmt.Errorf("bad api response for artist: %s", artistId))
+			}
+		}
+
+		if hasNextPage && known != nil {
+			releases := res.GetArtists[0].Discography.All.Releases
+			isKnown := true
+			for _, release := range releases[pageStart:] {
+				if release.ID != "" && !known[release.ID] {
+					isKnown = false
+					break
+				}
+			}
+			if isKnown {
+				fmt.Printf("artistId: %v, no new releases after %v, stop paging\n", artistId, len(releases))
+				hasNextPage = false
+				complete = false
 			}
 		}
 	}
 
-	return &res, err
+	return &res, complete, err
 }
 
 func downloadAlbumCover(ctx context.Context, url, path string) error {
@@ -230,11 +424,7 @@ func downloadAlbumCover(ctx context.Context, url, path string) error {
 	if err != nil {
 		return nil
 	}
-	response, err := http.DefaultClient.Do(req)
-	if err != nil {
-		return err
-	}
-
+	response, err := mediaClient.Do(req)
 	if err != nil || response == nil {
 		return err
 	}
@@ -252,18 +442,42 @@ func downloadAlbumCover(ctx context.Context, url, path string) error {
 	return err
 }
 
-func downloadFiles(ctx context.Context, trackId, token, trackQuality string, albInfo *AlbumInfo, mDownloaded map[string]string) {
+func newTrackEvent(eventType int32, trackId string, albInfo *AlbumInfo) *artist.DownloadEvent {
+	trNum, _ := strconv.Atoi(albInfo.TrackNum)
+	trTotal, _ := strconv.Atoi(albInfo.TrackTotal)
+	return &artist.DownloadEvent{
+		EventType:  eventType,
+		AlbumId:    albInfo.AlbumId,
+		TrackId:    trackId,
+		Title:      albInfo.TrackTitle,
+		TrackNum:   int32(trNum),
+		TrackTotal: int32(trTotal),
+	}
+}
+
+func trackFailed(progress DownloadProgress, trackId string, albInfo *AlbumInfo, reason string, err error) {
+	event := newTrackEvent(downloadEventFailed, trackId, albInfo)
+	event.Error = reason
+	if err != nil {
+		event.Error = fmt.Sprintf("%s: %v", reason, err)
+	}
+	progress.emit(event)
+}
+
+func downloadFiles(ctx context.Context, trackId, token, trackQuality string, albInfo *AlbumInfo, mDownloaded map[string]string, progress DownloadProgress) {
 	var coverPath string
 
 	cdnUrl, err := getTrackStreamUrl(ctx, trackId, trackQuality, token)
 	if err != nil || cdnUrl == "" {
 		log.Println("Failed to get track info from api.", err)
+		trackFailed(progress, trackId, albInfo, "failed to get track info from api", err)
 		return
 	}
 
 	curQuality := getCurrentTrackQuality(cdnUrl, &trackQualityMap)
 	if curQuality == nil {
 		log.Println("The API returned an unsupported format.")
+		trackFailed(progress, trackId, albInfo, "the api returned an unsupported format", nil)
 		return
 	}
 	mTrack := CreateTagsFromDb(albInfo)
@@ -301,6 +515,7 @@ func downloadFiles(ctx context.Context, trackId, token, trackQuality string, alb
 		err = os.MkdirAll(absAlbName, 0o755)
 		if err != nil {
 			fmt.Println(trackName+" can't create folder.", err)
+			trackFailed(progress, trackId, albInfo, "can't create folder", err)
 			return
 		}
 
@@ -317,18 +532,39 @@ func downloadFiles(ctx context.Context, trackId, token, trackQuality string, alb
 	exists, err := FileExists(trackPath)
 	if err != nil {
 		fmt.Println(trackName + " can't check if track already exists locally, skipped..")
+		trackFailed(progress, trackId, albInfo, "can't check if track already exists locally", err)
 		return
 	}
 
 	if exists {
 		fmt.Println(trackName + " exists locally, skipped..")
+		skipped := newTrackEvent(downloadEventSkipped, trackId, albInfo)
+		skipped.Quality = curQuality.Specs
+		progress.emit(skipped)
 		return
 	}
 
 	fmt.Printf("Downloading track %s of %s: %s - %s\n", albInfo.TrackNum, albInfo.TrackTotal, albInfo.TrackTitle, curQuality.Specs)
-	resDown, err := downloadTrack(ctx, trackPath, cdnUrl)
+	started := newTrackEvent(downloadEventStarted, trackId, albInfo)
+	started.Quality = curQuality.Specs
+	progress.emit(started)
+
+	var onProgress func(downloaded, total, speed int64)
+	if progress != nil {
+		onProgress = func(downloaded, total, speed int64) {
+			event := newTrackEvent(downloadEventProgress, trackId, albInfo)
+			event.Quality = curQuality.Specs
+			event.Bytes = downloaded
+			event.TotalBytes = total
+			event.Speed = speed
+			progress.emit(event)
+		}
+	}
+
+	resDown, err := downloadTrack(ctx, trackPath, cdnUrl, onProgress)
 	if err != nil {
 		fmt.Println(trackName+" can't download.", err)
+		trackFailed(progress, trackId, albInfo, "can't download", err)
 		return
 	}
 	mDownloaded[trackId] = resDown
@@ -336,8 +572,12 @@ func downloadFiles(ctx context.Context, trackId, token, trackQuality string, alb
 	err = WriteTags(trackPath, coverPath, curQuality.IsFlac, mTrack)
 	if err != nil {
 		fmt.Println(trackName+" can't write tags.", err)
+		trackFailed(progress, trackId, albInfo, "can't write tags", err)
 		return
 	}
+	tagged := newTrackEvent(downloadEventTagged, trackId, albInfo)
+	tagged.Quality = curQuality.Specs
+	progress.emit(tagged)
 
 	if trTotal == 1 && coverPath != "" {
 		err = os.Remove(coverPath)
@@ -345,9 +585,13 @@ func downloadFiles(ctx context.Context, trackId, token, trackQuality string, alb
 			fmt.Println(trackName+" can't delete cover.", err)
 		}
 	}
+
+	finished := newTrackEvent(downloadEventFinished, trackId, albInfo)
+	finished.Quality = curQuality.Specs
+	progress.emit(finished)
 }
 
-func downloadTrack(ctx context.Context, trackPath, url string) (string, error) {
+func downloadTrack(ctx context.Context, trackPath, url string, onProgress func(downloaded, total, speed int64)) (string, error) {
 	f, err := os.OpenFile(trackPath, os.O_CREATE|os.O_WRONLY, 0o755)
 	if err != nil {
 		return "", err
@@ -365,9 +609,7 @@ func downloadTrack(ctx context.Context, trackPath, url string) (string, error) {
 	}
 
 	req.Header.Add("Range", "bytes=0-")
-	client := &http.Client{Jar: jar, Transport: &Transport{}}
-	defer client.CloseIdleConnections()
-	do, err := client.Do(req)
+	do, err := zvukFileClient.Do(req)
 	if err != nil || do == nil {
 		return "", err
 	}
@@ -384,9 +626,10 @@ func downloadTrack(ctx context.Context, trackPath, url string) (string, error) {
 	}
 	totalBytes := do.ContentLength
 	counter := &WriteCounter{
-		Total:     totalBytes,
-		TotalStr:  humanize.Bytes(uint64(totalBytes)),
-		StartTime: time.Now().UnixMilli(),
+		Total:      totalBytes,
+		TotalStr:   humanize.Bytes(uint64(totalBytes)),
+		StartTime:  time.Now().UnixMilli(),
+		OnProgress: onProgress,
 	}
 	res, err := io.Copy(f, io.TeeReader(do.Body, counter))
 
@@ -395,7 +638,6 @@ func downloadTrack(ctx context.Context, trackPath, url string) (string, error) {
 }
 
 func getTrackStreamUrl(ctx context.Context, trackId, trackQuality, token string) (string, error) {
-	var do *http.Response
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, apiBase+apiStream, nil)
 	if err != nil {
 		return "", err
@@ -404,30 +646,9 @@ func getTrackStreamUrl(ctx context.Context, trackId, trackQuality, token string)
 	query.Set("id", trackId)
 	query.Set("quality", trackQuality)
 	req.URL.RawQuery = query.Encode()
-	client := &http.Client{Jar: jar, Transport: &Transport{auth: token}}
-	defer client.CloseIdleConnections()
-	for i := 0; i < 5; i++ {
-		do, err = client.Do(req)
-		if err != nil || do == nil {
-			return "", err
-		}
-		if do.StatusCode == http.StatusTeapot && i != 4 {
-			err = do.Body.Close()
-			if err != nil {
-				return "", err
-			}
-			fmt.Printf("Got a HTTP 418, %d attempt(s) remaining.\n", 4-i)
-
-			continue
-		}
-
-		if do.StatusCode != http.StatusOK {
-			return "", fmt.Errorf("status code:  %d", do.StatusCode)
-		}
-
-		break
-	}
-	if do == nil {
+	setAuthCookie(req, token)
+	do, err := zvukFileClient.Do(req)
+	if err != nil || do == nil {
 		return "", err
 	}
 
@@ -438,6 +659,10 @@ func getTrackStreamUrl(ctx context.Context, trackId, trackQuality, token string)
 		}
 	}(do.Body)
 
+	if do.StatusCode != http.StatusOK {
+		return "", httpError(providerZvuk, do)
+	}
+
 	var obj *TrackStreamInfo
 	err = json.NewDecoder(do.Body).Decode(&obj)
 	if err != nil || obj == nil {
@@ -447,36 +672,14 @@ func getTrackStreamUrl(ctx context.Context, trackId, trackQuality, token string)
 }
 
 func getReleaseInfo(ctx context.Context, releaseId, token string) (map[string]string, error) {
-	var do *http.Response
 	mAlbumTitles := make(map[string]string)
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, apiBase+apiReleaseJson+releaseId+".json", nil)
 	if err != nil {
 		return mAlbumTitles, err
 	}
-	client := &http.Client{Jar: jar, Transport: &Transport{auth: token}}
-	defer client.CloseIdleConnections()
-	for i := 0; i < 5; i++ {
-		do, err = client.Do(req)
-		if err != nil || do == nil {
-			return mAlbumTitles, err
-		}
-		if do.StatusCode == http.StatusTeapot && i != 4 {
-			err = do.Body.Close()
-			if err != nil {
-				return mAlbumTitles, err
-			}
-			fmt.Printf("Got a HTTP 418, %d attempt(s) remaining.\n", 4-i)
-
-			continue
-		}
-
-		if do.StatusCode != http.StatusOK {
-			return mAlbumTitles, fmt.Errorf("status code:  %d", do.StatusCode)
-		}
-
-		break
-	}
-	if do == nil {
+	setAuthCookie(req, token)
+	do, err := zvukClient.Do(req)
+	if err != nil || do == nil {
 		return mAlbumTitles, err
 	}
 
@@ -487,6 +690,10 @@ func getReleaseInfo(ctx context.Context, releaseId, token string) (map[string]st
 		}
 	}(do.Body)
 
+	if do.StatusCode != http.StatusOK {
+		return mAlbumTitles, httpError(providerZvuk, do)
+	}
+
 	var obj *ReleaseInfoJson
 	err = json.NewDecoder(do.Body).Decode(&obj)
 	if err != nil || obj == nil {
@@ -0,0 +1,44 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDownloadTrackSlowBody(t *testing.T) {
+	chunk := strings.Repeat("f", 1024)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Length", "5120")
+		for range 5 {
+			_, _ = w.Write([]byte(chunk))
+			w.(http.Flusher).Flush()
+			time.Sleep(50 * time.Millisecond)
+		}
+	}))
+	defer server.Close()
+
+	// таймаут апи короче тела, файл должен качаться мимо него
+	timeout := zvukClient.Timeout
+	zvukClient.Timeout = 100 * time.Millisecond
+	t.Cleanup(func() {
+		zvukClient.Timeout = timeout
+	})
+
+	trackPath := filepath.Join(t.TempDir(), "track.flac")
+	if _, err := downloadTrack(context.Background(), trackPath, server.URL, nil); err != nil {
+		t.Fatalf("downloadTrack: %v", err)
+	}
+	data, err := os.ReadFile(trackPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(data) != 5*len(chunk) {
+		t.Fatalf("got %v bytes, want %v", len(data), 5*len(chunk))
+	}
+}